@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"sort"
+)
+
+// checkParity compares the exported declarations of the packages in srcDir
+// and dstDir and returns a description of each difference.
+func checkParity(srcDir, dstDir string) ([]string, error) {
+	srcAPI, err := collectAPI(srcDir)
+	if err != nil {
+		return nil, err
+	}
+	// The source declarations are converted to their expected names and
+	// signatures in the destination package.
+	expectedAPI := make(map[string]string, len(srcAPI))
+	for key, signature := range srcAPI {
+		expectedAPI[nameReplacer.Replace(key)] = nameReplacer.Replace(signature)
+	}
+	dstAPI, err := collectAPI(dstDir)
+	if err != nil {
+		return nil, err
+	}
+	srcName := packageDirName(srcDir)
+	dstName := packageDirName(dstDir)
+
+	var problems []string
+	for key, expectedSignature := range expectedAPI {
+		dstSignature, ok := dstAPI[key]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: missing %s (declared in %s)", dstName, key, srcName))
+		case expectedSignature != dstSignature:
+			problems = append(problems, fmt.Sprintf("%s: %s has signature %s, expected %s", dstName, key, dstSignature, expectedSignature))
+		}
+	}
+	for key := range dstAPI {
+		if _, ok := expectedAPI[key]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: missing %s (declared in %s)", srcName, key, dstName))
+		}
+	}
+	sort.Strings(problems)
+	return problems, nil
+}
+
+// collectAPI returns the exported declarations of the package in dir,
+// mapped to their printed signatures.
+func collectAPI(dir string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	_, files, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if !decl.Name.IsExported() {
+					continue
+				}
+				key := "func " + decl.Name.Name
+				if decl.Recv != nil {
+					receiver := receiverName(decl.Recv.List[0].Type)
+					if !ast.IsExported(receiver) {
+						continue
+					}
+					key = "method " + receiver + "." + decl.Name.Name
+				}
+				result[key] = printNode(fset, decl.Type)
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.IsExported() {
+							result["type "+spec.Name.Name] = printNode(fset, spec.Type)
+						}
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							if name.IsExported() {
+								result[decl.Tok.String()+" "+name.Name] = ""
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.IndexExpr:
+		return receiverName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	default:
+		return ""
+	}
+}
+
+func printNode(fset *token.FileSet, node ast.Node) string {
+	var buffer bytes.Buffer
+	printer.Fprint(&buffer, fset, node)
+	return buffer.String()
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Check", func() {
+	var (
+		srcDir string
+		dstDir string
+	)
+
+	writeFile := func(dir, content string) {
+		Expect(os.WriteFile(filepath.Join(dir, "api.go"), []byte(content), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		srcDir = filepath.Join(GinkgoT().TempDir(), "dprec")
+		dstDir = filepath.Join(GinkgoT().TempDir(), "sprec")
+		Expect(os.Mkdir(srcDir, 0o755)).To(Succeed())
+		Expect(os.Mkdir(dstDir, 0o755)).To(Succeed())
+	})
+
+	Specify("matching packages", func() {
+		writeFile(srcDir, `package dprec
+
+const Epsilon = 0.000000000001
+
+type Vec struct{ X float64 }
+
+func (v Vec) Length() float64 { return v.X }
+
+func EqualFloat64(a, b float64) bool { return a == b }
+
+func helper() {}
+`)
+		writeFile(dstDir, `package sprec
+
+const Epsilon = 0.000001
+
+type Vec struct{ X float32 }
+
+func (v Vec) Length() float32 { return v.X }
+
+func EqualFloat32(a, b float32) bool { return a == b }
+`)
+		Expect(checkParity(srcDir, dstDir)).To(BeEmpty())
+	})
+
+	Specify("differing packages", func() {
+		writeFile(srcDir, `package dprec
+
+type Vec struct{ X float64 }
+
+func (v Vec) Length() float64 { return v.X }
+
+func Forgotten() {}
+`)
+		writeFile(dstDir, `package sprec
+
+type Vec struct{ X float32 }
+
+func (v Vec) Length() float64 { return float64(v.X) }
+
+func Extra() {}
+`)
+		Expect(checkParity(srcDir, dstDir)).To(Equal([]string{
+			"dprec: missing func Extra (declared in sprec)",
+			"sprec: method Vec.Length has signature func() float64, expected func() float32",
+			"sprec: missing func Forgotten (declared in dprec)",
+		}))
+	})
+
+	DescribeTable("repository packages",
+		func(srcDir, dstDir string) {
+			Expect(checkParity(srcDir, dstDir)).To(BeEmpty())
+		},
+		Entry("sprec", "../../dprec", "../../sprec"),
+		Entry("sprectest", "../../testing/dprectest", "../../testing/sprectest"),
+	)
+})
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// nameReplacer is applied to all identifiers that do not belong to the
+// standard library.
+var nameReplacer = strings.NewReplacer(
+	"dprec", "sprec",
+	"float64", "float32",
+	"Float64", "Float32",
+)
+
+// textReplacer is applied to comments and import paths.
+var textReplacer = strings.NewReplacer(
+	"dprec", "sprec",
+	"float64", "float32",
+	"Float64", "Float32",
+	"Double Precision", "Single Precision",
+	"double precision", "single precision",
+	"double-precision", "single-precision",
+)
+
+// literalReplacements maps precision-dependent float literals of the double
+// precision packages to their single precision counterparts.
+var literalReplacements = map[string]string{
+	"0.000000000001": "0.000001", // Epsilon
+}
+
+const generatedMarker = "// Code generated by precgen"
+
+// generatePackage converts all non-test source files in srcDir and writes
+// them to dstDir. Previously generated files in dstDir that no longer have
+// a source counterpart are removed.
+func generatePackage(srcDir, dstDir string, skip map[string]bool) error {
+	fset := token.NewFileSet()
+	names, files, err := parseDir(fset, srcDir)
+	if err != nil {
+		return err
+	}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	config := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+	}
+	// The package path needs to be outside of the standard library namespace,
+	// so that calls to local functions are not considered for conversion.
+	if _, err := config.Check("precgen.local/"+files[0].Name.Name, fset, files, info); err != nil {
+		return fmt.Errorf("error type-checking %q: %w", srcDir, err)
+	}
+
+	for i, file := range files {
+		if skip[names[i]] {
+			continue
+		}
+		source, err := os.ReadFile(filepath.Join(srcDir, names[i]))
+		if err != nil {
+			return fmt.Errorf("error reading source file: %w", err)
+		}
+		origin := packageDirName(srcDir) + "/" + names[i]
+		result, err := convertFile(fset, file, info, source, origin)
+		if err != nil {
+			return fmt.Errorf("error converting %q: %w", origin, err)
+		}
+		if err := os.WriteFile(filepath.Join(dstDir, names[i]), result, 0o644); err != nil {
+			return fmt.Errorf("error writing generated file: %w", err)
+		}
+	}
+	return removeStaleFiles(dstDir, names)
+}
+
+func parseDir(fset *token.FileSet, dir string) ([]string, []*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading directory: %w", err)
+	}
+	var (
+		names []string
+		files []*ast.File
+	)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing source file: %w", err)
+		}
+		names = append(names, name)
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("no source files in %q", dir)
+	}
+	return names, files, nil
+}
+
+func removeStaleFiles(dstDir string, sourceNames []string) error {
+	entries, err := os.ReadDir(dstDir)
+	if err != nil {
+		return fmt.Errorf("error reading directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || slices.Contains(sourceNames, name) {
+			continue
+		}
+		path := filepath.Join(dstDir, name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading file: %w", err)
+		}
+		if bytes.HasPrefix(content, []byte(generatedMarker)) {
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("error removing stale file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// convertFile produces the single precision version of the specified double
+// precision source file.
+//
+// Apart from renaming identifiers, the conversion ensures that values that
+// are passed to or returned from the standard library (e.g. the math
+// package) are converted between float32 and float64 where necessary.
+func convertFile(fset *token.FileSet, file *ast.File, info *types.Info, source []byte, origin string) ([]byte, error) {
+	c := &converter{
+		fset:   fset,
+		info:   info,
+		base:   fset.File(file.Pos()).Base(),
+		keep:   make(map[*ast.Ident]bool),
+		native: make(map[*ast.CallExpr]bool),
+	}
+	c.convertCalls(file)
+	c.convertIdents(file)
+	c.convertText(file)
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "%s from %s. DO NOT EDIT.\n\n", generatedMarker, origin)
+	buffer.Write(c.apply(source))
+	return format.Source(buffer.Bytes())
+}
+
+type editKind uint8
+
+const (
+	editClose editKind = iota
+	editOpen
+	editReplace
+)
+
+type edit struct {
+	start int
+	end   int
+	kind  editKind
+	text  string
+}
+
+type converter struct {
+	fset   *token.FileSet
+	info   *types.Info
+	base   int
+	edits  []edit
+	keep   map[*ast.Ident]bool
+	native map[*ast.CallExpr]bool
+}
+
+func (c *converter) offset(pos token.Pos) int {
+	return int(pos) - c.base
+}
+
+func (c *converter) wrap(expr ast.Expr, typeName string) {
+	c.edits = append(c.edits,
+		edit{start: c.offset(expr.Pos()), end: c.offset(expr.Pos()), kind: editOpen, text: typeName + "("},
+		edit{start: c.offset(expr.End()), end: c.offset(expr.End()), kind: editClose, text: ")"},
+	)
+}
+
+func (c *converter) replace(node ast.Node, text string) {
+	c.edits = append(c.edits, edit{start: c.offset(node.Pos()), end: c.offset(node.End()), kind: editReplace, text: text})
+}
+
+func (c *converter) convertCalls(file *ast.File) {
+	var stack []ast.Node
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		var parent ast.Node
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+		stack = append(stack, node)
+		if call, ok := node.(*ast.CallExpr); ok {
+			c.convertCall(call, parent)
+		}
+		return true
+	})
+}
+
+func (c *converter) convertCall(call *ast.CallExpr, parent ast.Node) {
+	signature, ok := c.stdlibSignature(call)
+	if !ok {
+		return
+	}
+	params := signature.Params()
+	for i, arg := range call.Args {
+		var paramType types.Type
+		switch {
+		case signature.Variadic() && i >= params.Len()-1:
+			paramType = params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+		default:
+			paramType = params.At(i).Type()
+		}
+		if !isFloat64(paramType) || c.info.Types[arg].Value != nil {
+			continue
+		}
+		if ident, ok := c.float64Conversion(arg); ok {
+			c.keep[ident] = true
+			continue
+		}
+		if argCall, ok := ast.Unparen(arg).(*ast.CallExpr); ok {
+			if argSignature, ok := c.stdlibSignature(argCall); ok && returnsFloat64(argSignature) {
+				c.native[argCall] = true
+				continue
+			}
+		}
+		c.wrap(arg, "float64")
+	}
+	if returnsFloat64(signature) && !c.native[call] && !c.isConversion(parent) {
+		c.wrap(call, "float32")
+	}
+}
+
+func (c *converter) stdlibSignature(call *ast.CallExpr) (*types.Signature, bool) {
+	selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	function, ok := c.info.Uses[selector.Sel].(*types.Func)
+	if !ok || !isStdlib(function.Pkg()) {
+		return nil, false
+	}
+	signature, ok := function.Type().(*types.Signature)
+	return signature, ok
+}
+
+func (c *converter) float64Conversion(expr ast.Expr) (*ast.Ident, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, false
+	}
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok || !c.info.Types[ident].IsType() || !isFloat64(c.info.Types[ident].Type) {
+		return nil, false
+	}
+	return ident, true
+}
+
+func (c *converter) isConversion(node ast.Node) bool {
+	call, ok := node.(*ast.CallExpr)
+	return ok && c.info.Types[call.Fun].IsType()
+}
+
+func (c *converter) convertIdents(file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		ident, ok := node.(*ast.Ident)
+		if !ok || c.keep[ident] {
+			return true
+		}
+		if object, ok := c.info.Uses[ident]; ok && object.Pkg() != nil && isStdlib(object.Pkg()) {
+			return true
+		}
+		if name := nameReplacer.Replace(ident.Name); name != ident.Name {
+			c.replace(ident, name)
+		}
+		return true
+	})
+}
+
+func (c *converter) convertText(file *ast.File) {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if text := textReplacer.Replace(comment.Text); text != comment.Text {
+				c.replace(comment, text)
+			}
+		}
+	}
+	for _, spec := range file.Imports {
+		if path := textReplacer.Replace(spec.Path.Value); path != spec.Path.Value {
+			c.replace(spec.Path, path)
+		}
+	}
+	ast.Inspect(file, func(node ast.Node) bool {
+		literal, ok := node.(*ast.BasicLit)
+		if !ok || literal.Kind != token.FLOAT {
+			return true
+		}
+		if value, ok := literalReplacements[literal.Value]; ok {
+			c.replace(literal, value)
+		}
+		return true
+	})
+}
+
+func (c *converter) apply(source []byte) []byte {
+	// Insertions at the same offset need to be applied in a specific order:
+	// closing parentheses of inner expressions go before opening
+	// parentheses of outer ones.
+	sort.SliceStable(c.edits, func(i, j int) bool {
+		if c.edits[i].start != c.edits[j].start {
+			return c.edits[i].start < c.edits[j].start
+		}
+		return c.edits[i].kind < c.edits[j].kind
+	})
+	var (
+		result bytes.Buffer
+		cursor int
+	)
+	for _, e := range c.edits {
+		result.Write(source[cursor:e.start])
+		result.WriteString(e.text)
+		cursor = max(cursor, e.end)
+	}
+	result.Write(source[cursor:])
+	return result.Bytes()
+}
+
+func isStdlib(pkg *types.Package) bool {
+	first, _, _ := strings.Cut(pkg.Path(), "/")
+	return !strings.Contains(first, ".")
+}
+
+func isFloat64(t types.Type) bool {
+	return types.Identical(t, types.Typ[types.Float64])
+}
+
+func returnsFloat64(signature *types.Signature) bool {
+	return signature.Results().Len() == 1 && isFloat64(signature.Results().At(0).Type())
+}
+
+func packageDirName(dir string) string {
+	if absDir, err := filepath.Abs(dir); err == nil {
+		dir = absDir
+	}
+	return filepath.Base(dir)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generate", func() {
+	var (
+		srcDir string
+		dstDir string
+	)
+
+	writeFile := func(dir, name, content string) {
+		Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)).To(Succeed())
+	}
+
+	readFile := func(dir, name string) string {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	BeforeEach(func() {
+		srcDir = filepath.Join(GinkgoT().TempDir(), "dprec")
+		dstDir = filepath.Join(GinkgoT().TempDir(), "sprec")
+		Expect(os.Mkdir(srcDir, 0o755)).To(Succeed())
+		Expect(os.Mkdir(dstDir, 0o755)).To(Succeed())
+	})
+
+	Specify("generatePackage", func() {
+		writeFile(srcDir, "util.go", `package dprec
+
+import "math"
+
+// Epsilon is the double precision tolerance.
+const Epsilon = float64(0.000000000001)
+
+// Length returns the float64 length.
+func Length(x, y float64) float64 {
+	return math.Sqrt(x*x + y*y)
+}
+
+// Remainder returns the remainder of a/b.
+func Remainder(a, b float64) float64 {
+	return float64(math.Mod(a, b))
+}
+
+// IsBroken returns true if value is NaN or Inf.
+func IsBroken(value float64) bool {
+	return math.IsNaN(value) || math.IsInf(value, 0) || value == math.Inf(1)
+}
+
+// AbsSin returns the absolute sine.
+func AbsSin(value float64) float64 {
+	return math.Abs(math.Sin(value))
+}
+`)
+		writeFile(srcDir, "util_test.go", "package dprec\n")
+		writeFile(srcDir, "manual.go", "package dprec\n")
+		writeFile(dstDir, "stale.go", generatedMarker+" from dprec/stale.go. DO NOT EDIT.\n\npackage sprec\n")
+		writeFile(dstDir, "custom.go", "package sprec\n")
+
+		Expect(generatePackage(srcDir, dstDir, map[string]bool{"manual.go": true})).To(Succeed())
+
+		Expect(readFile(dstDir, "util.go")).To(Equal(`// Code generated by precgen from dprec/util.go. DO NOT EDIT.
+
+package sprec
+
+import "math"
+
+// Epsilon is the single precision tolerance.
+const Epsilon = float32(0.000001)
+
+// Length returns the float32 length.
+func Length(x, y float32) float32 {
+	return float32(math.Sqrt(float64(x*x + y*y)))
+}
+
+// Remainder returns the remainder of a/b.
+func Remainder(a, b float32) float32 {
+	return float32(math.Mod(float64(a), float64(b)))
+}
+
+// IsBroken returns true if value is NaN or Inf.
+func IsBroken(value float32) bool {
+	return math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) || value == float32(math.Inf(1))
+}
+
+// AbsSin returns the absolute sine.
+func AbsSin(value float32) float32 {
+	return float32(math.Abs(math.Sin(float64(value))))
+}
+`))
+		Expect(filepath.Join(dstDir, "util_test.go")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dstDir, "manual.go")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dstDir, "stale.go")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dstDir, "custom.go")).To(BeAnExistingFile())
+	})
+
+	DescribeTable("generated packages are up to date",
+		func(srcDir, dstDir string, skip map[string]bool) {
+			outputDir := GinkgoT().TempDir()
+			Expect(generatePackage(srcDir, outputDir, skip)).To(Succeed())
+
+			entries, err := os.ReadDir(outputDir)
+			Expect(err).ToNot(HaveOccurred())
+			for _, entry := range entries {
+				Expect(readFile(dstDir, entry.Name())).To(Equal(readFile(outputDir, entry.Name())),
+					"%s is out of date, run go generate", entry.Name())
+			}
+		},
+		Entry("sprec", "../../dprec", "../../sprec", nil),
+		Entry("sprectest", "../../testing/dprectest", "../../testing/sprectest", map[string]bool{"common.go": true}),
+	)
+})
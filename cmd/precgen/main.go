@@ -0,0 +1,70 @@
+// Command precgen generates the single precision packages from their double
+// precision counterparts and verifies that the exported APIs of the two
+// precision levels match.
+//
+// In generate mode, every non-test source file of the source package is
+// converted and written to the destination package:
+//
+//	precgen -src ../dprec -dst .
+//
+// In check mode, nothing is written. Instead, the command fails if a
+// declaration exists in only one of the two packages or if its signature
+// differs by more than the floating point precision:
+//
+//	precgen -check -src ./dprec -dst ./sprec
+//
+// Test files are not generated, since their expected values and tolerances
+// depend on the precision.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	var (
+		srcDir    string
+		dstDir    string
+		skipFiles string
+		check     bool
+	)
+	flag.StringVar(&srcDir, "src", "", "directory of the double precision package")
+	flag.StringVar(&dstDir, "dst", "", "directory of the single precision package")
+	flag.StringVar(&skipFiles, "skip", "", "comma-separated list of source files that are maintained by hand")
+	flag.BoolVar(&check, "check", false, "verify API parity instead of generating")
+	flag.Parse()
+
+	if srcDir == "" || dstDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if check {
+		problems, err := checkParity(srcDir, dstDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "precgen: %v\n", err)
+			os.Exit(1)
+		}
+		for _, problem := range problems {
+			fmt.Fprintln(os.Stderr, problem)
+		}
+		if len(problems) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	skip := make(map[string]bool)
+	for _, name := range strings.Split(skipFiles, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			skip[name] = true
+		}
+	}
+	if err := generatePackage(srcDir, dstDir, skip); err != nil {
+		fmt.Fprintf(os.Stderr, "precgen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -1,4 +1,5 @@
 // Package gomath contains an advanced mathematics library for the Go programming language.
 // Check the sprec and dprec sub-packages for functions and types in the single and double floating point
-// precision levels respectively.
+// precision levels respectively. Both are built on top of the gen sub-package, which contains the
+// vector, quaternion and matrix implementation in a form that is generic over the precision.
 package gomath
@@ -0,0 +1,220 @@
+package dprec
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewAABB creates an AABB with the given min and max corners.
+func NewAABB(minPoint, maxPoint Vec3) AABB {
+	return AABB{
+		Min: minPoint,
+		Max: maxPoint,
+	}
+}
+
+// EmptyAABB returns an AABB that contains no points. Its Min corner is at
+// positive infinity and its Max corner is at negative infinity, which makes
+// it the identity element for AABBUnion.
+func EmptyAABB() AABB {
+	inf := math.Inf(1)
+	return AABB{
+		Min: NewVec3(inf, inf, inf),
+		Max: NewVec3(-inf, -inf, -inf),
+	}
+}
+
+// CenterAABB creates an AABB from a center point and half-extents along
+// each axis.
+func CenterAABB(center, extent Vec3) AABB {
+	return AABB{
+		Min: Vec3Diff(center, extent),
+		Max: Vec3Sum(center, extent),
+	}
+}
+
+// PointAABB returns the smallest AABB that contains all of the given points.
+func PointAABB(first Vec3, others ...Vec3) AABB {
+	result := AABB{
+		Min: first,
+		Max: first,
+	}
+	for _, point := range others {
+		result = aabbWithPoint(result, point)
+	}
+	return result
+}
+
+// SliceAABB returns the smallest AABB that contains all points in the
+// given slice. If the slice is empty, EmptyAABB is returned.
+func SliceAABB(points []Vec3) AABB {
+	result := EmptyAABB()
+	for _, point := range points {
+		result = aabbWithPoint(result, point)
+	}
+	return result
+}
+
+// AABBUnion returns the smallest AABB that contains both a and b.
+func AABBUnion(a, b AABB) AABB {
+	return AABB{
+		Min: NewVec3(min(a.Min.X, b.Min.X), min(a.Min.Y, b.Min.Y), min(a.Min.Z, b.Min.Z)),
+		Max: NewVec3(max(a.Max.X, b.Max.X), max(a.Max.Y, b.Max.Y), max(a.Max.Z, b.Max.Z)),
+	}
+}
+
+// AABBIntersection returns the AABB that is shared by both a and b. If the
+// two boxes do not overlap, the result is empty (see AABB.IsEmpty).
+func AABBIntersection(a, b AABB) AABB {
+	return AABB{
+		Min: NewVec3(max(a.Min.X, b.Min.X), max(a.Min.Y, b.Min.Y), max(a.Min.Z, b.Min.Z)),
+		Max: NewVec3(min(a.Max.X, b.Max.X), min(a.Max.Y, b.Max.Y), min(a.Max.Z, b.Max.Z)),
+	}
+}
+
+// ExpandedAABB returns the AABB grown by margin in all directions. A
+// negative margin shrinks the box.
+func ExpandedAABB(box AABB, margin float64) AABB {
+	offset := NewVec3(margin, margin, margin)
+	return AABB{
+		Min: Vec3Diff(box.Min, offset),
+		Max: Vec3Sum(box.Max, offset),
+	}
+}
+
+// Mat4AABBTransformation applies the 3D transformation to an AABB and
+// returns the smallest AABB that encloses the eight transformed corners of
+// the original box.
+//
+// If the last row of the matrix is not (0, 0, 0, 1), as is the case for
+// projection matrices, the corners are transformed as points with W set
+// to one and are then divided by the resulting W. The behavior is
+// undefined if any of the corners ends up with a W that is not positive
+// (i.e. behind the camera of a perspective projection).
+func Mat4AABBTransformation(mat Mat4, box AABB) AABB {
+	if box.IsEmpty() {
+		return box
+	}
+	if mat.M41 != 0.0 || mat.M42 != 0.0 || mat.M43 != 0.0 || mat.M44 != 1.0 {
+		return mat4AABBProjection(mat, box)
+	}
+	// This is the method of Jim Arvo from Graphics Gems, which produces
+	// the same result as transforming all eight corners but in fewer steps.
+	result := AABB{
+		Min: mat.Translation(),
+		Max: mat.Translation(),
+	}
+	aabbAccumulateAxis(&result.Min.X, &result.Max.X, mat.M11, box.Min.X, box.Max.X)
+	aabbAccumulateAxis(&result.Min.X, &result.Max.X, mat.M12, box.Min.Y, box.Max.Y)
+	aabbAccumulateAxis(&result.Min.X, &result.Max.X, mat.M13, box.Min.Z, box.Max.Z)
+	aabbAccumulateAxis(&result.Min.Y, &result.Max.Y, mat.M21, box.Min.X, box.Max.X)
+	aabbAccumulateAxis(&result.Min.Y, &result.Max.Y, mat.M22, box.Min.Y, box.Max.Y)
+	aabbAccumulateAxis(&result.Min.Y, &result.Max.Y, mat.M23, box.Min.Z, box.Max.Z)
+	aabbAccumulateAxis(&result.Min.Z, &result.Max.Z, mat.M31, box.Min.X, box.Max.X)
+	aabbAccumulateAxis(&result.Min.Z, &result.Max.Z, mat.M32, box.Min.Y, box.Max.Y)
+	aabbAccumulateAxis(&result.Min.Z, &result.Max.Z, mat.M33, box.Min.Z, box.Max.Z)
+	return result
+}
+
+// mat4AABBProjection transforms each of the eight corners of the box with
+// a perspective divide and returns the box that encloses them.
+func mat4AABBProjection(mat Mat4, box AABB) AABB {
+	result := EmptyAABB()
+	for i := range 8 {
+		corner := box.Min
+		if i&1 != 0 {
+			corner.X = box.Max.X
+		}
+		if i&2 != 0 {
+			corner.Y = box.Max.Y
+		}
+		if i&4 != 0 {
+			corner.Z = box.Max.Z
+		}
+		projected := Mat4Vec4Prod(mat, NewVec4(corner.X, corner.Y, corner.Z, 1.0))
+		result = aabbWithPoint(result, Vec3Quot(projected.VecXYZ(), projected.W))
+	}
+	return result
+}
+
+func aabbAccumulateAxis(resultMin, resultMax *float64, factor, lower, upper float64) {
+	a := factor * lower
+	b := factor * upper
+	*resultMin += min(a, b)
+	*resultMax += max(a, b)
+}
+
+func aabbWithPoint(box AABB, point Vec3) AABB {
+	return AABB{
+		Min: NewVec3(min(box.Min.X, point.X), min(box.Min.Y, point.Y), min(box.Min.Z, point.Z)),
+		Max: NewVec3(max(box.Max.X, point.X), max(box.Max.Y, point.Y), max(box.Max.Z, point.Z)),
+	}
+}
+
+// AABB is an axis-aligned bounding box defined by its Min and Max corners.
+//
+// Boundaries are inclusive, so a point that lies on a face of the box is
+// considered to be contained by it. A box where Min equals Max is a valid
+// box that contains a single point.
+type AABB struct {
+	Min Vec3
+	Max Vec3
+}
+
+// IsNaN returns true if any component is NaN.
+func (a AABB) IsNaN() bool {
+	return a.Min.IsNaN() || a.Max.IsNaN()
+}
+
+// IsEmpty returns true if the box contains no points (i.e. Min is larger
+// than Max along at least one axis).
+func (a AABB) IsEmpty() bool {
+	return a.Min.X > a.Max.X || a.Min.Y > a.Max.Y || a.Min.Z > a.Max.Z
+}
+
+// Center returns the center point of the box.
+func (a AABB) Center() Vec3 {
+	return Vec3Prod(Vec3Sum(a.Min, a.Max), 0.5)
+}
+
+// Extent returns the half-size of the box along each axis.
+func (a AABB) Extent() Vec3 {
+	return Vec3Prod(Vec3Diff(a.Max, a.Min), 0.5)
+}
+
+// Size returns the full size of the box along each axis.
+func (a AABB) Size() Vec3 {
+	return Vec3Diff(a.Max, a.Min)
+}
+
+// ContainsPoint returns true if the point is inside the box or on its
+// boundary.
+func (a AABB) ContainsPoint(point Vec3) bool {
+	return point.X >= a.Min.X && point.X <= a.Max.X &&
+		point.Y >= a.Min.Y && point.Y <= a.Max.Y &&
+		point.Z >= a.Min.Z && point.Z <= a.Max.Z
+}
+
+// ContainsAABB returns true if the other box is fully inside this box.
+// An empty box is contained by any box.
+func (a AABB) ContainsAABB(other AABB) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	return other.Min.X >= a.Min.X && other.Max.X <= a.Max.X &&
+		other.Min.Y >= a.Min.Y && other.Max.Y <= a.Max.Y &&
+		other.Min.Z >= a.Min.Z && other.Max.Z <= a.Max.Z
+}
+
+// Intersects returns true if the two boxes overlap. Boxes that only touch
+// along a face, edge or corner are considered to be intersecting.
+func (a AABB) Intersects(other AABB) bool {
+	return a.Min.X <= other.Max.X && a.Max.X >= other.Min.X &&
+		a.Min.Y <= other.Max.Y && a.Max.Y >= other.Min.Y &&
+		a.Min.Z <= other.Max.Z && a.Max.Z >= other.Min.Z
+}
+
+// String returns a string representation of the box.
+func (a AABB) String() string {
+	return fmt.Sprintf("(%s, %s)", a.Min, a.Max)
+}
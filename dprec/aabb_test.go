@@ -0,0 +1,162 @@
+package dprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("AABB", func() {
+	var box AABB
+
+	BeforeEach(func() {
+		box = NewAABB(NewVec3(-1.0, -2.0, -3.0), NewVec3(1.0, 2.0, 3.0))
+	})
+
+	Specify("NewAABB", func() {
+		Expect(box).To(HaveAABBBounds(-1.0, -2.0, -3.0, 1.0, 2.0, 3.0))
+	})
+
+	Specify("EmptyAABB", func() {
+		empty := EmptyAABB()
+		Expect(empty.IsEmpty()).To(BeTrue())
+		Expect(empty.ContainsPoint(ZeroVec3())).To(BeFalse())
+		Expect(AABBUnion(empty, box)).To(HaveAABBBounds(-1.0, -2.0, -3.0, 1.0, 2.0, 3.0))
+	})
+
+	Specify("CenterAABB", func() {
+		result := CenterAABB(NewVec3(1.0, 2.0, 3.0), NewVec3(0.5, 1.5, 2.5))
+		Expect(result).To(HaveAABBBounds(0.5, 0.5, 0.5, 1.5, 3.5, 5.5))
+	})
+
+	Specify("PointAABB", func() {
+		result := PointAABB(NewVec3(1.0, -1.0, 0.5))
+		Expect(result).To(HaveAABBBounds(1.0, -1.0, 0.5, 1.0, -1.0, 0.5))
+		Expect(result.IsEmpty()).To(BeFalse())
+
+		result = PointAABB(
+			NewVec3(1.0, -1.0, 0.5),
+			NewVec3(-2.0, 3.0, 0.0),
+			NewVec3(0.0, 0.0, 4.0),
+		)
+		Expect(result).To(HaveAABBBounds(-2.0, -1.0, 0.0, 1.0, 3.0, 4.0))
+	})
+
+	Specify("SliceAABB", func() {
+		result := SliceAABB([]Vec3{
+			NewVec3(1.0, -1.0, 0.5),
+			NewVec3(-2.0, 3.0, 0.0),
+			NewVec3(0.0, 0.0, 4.0),
+		})
+		Expect(result).To(HaveAABBBounds(-2.0, -1.0, 0.0, 1.0, 3.0, 4.0))
+
+		result = SliceAABB(nil)
+		Expect(result.IsEmpty()).To(BeTrue())
+	})
+
+	Specify("AABBUnion", func() {
+		other := NewAABB(NewVec3(0.0, 1.0, 2.0), NewVec3(5.0, 6.0, 7.0))
+		result := AABBUnion(box, other)
+		Expect(result).To(HaveAABBBounds(-1.0, -2.0, -3.0, 5.0, 6.0, 7.0))
+	})
+
+	Specify("AABBIntersection", func() {
+		other := NewAABB(NewVec3(0.0, 1.0, 2.0), NewVec3(5.0, 6.0, 7.0))
+		result := AABBIntersection(box, other)
+		Expect(result).To(HaveAABBBounds(0.0, 1.0, 2.0, 1.0, 2.0, 3.0))
+		Expect(result.IsEmpty()).To(BeFalse())
+
+		disjoint := NewAABB(NewVec3(2.0, 0.0, 0.0), NewVec3(3.0, 1.0, 1.0))
+		result = AABBIntersection(box, disjoint)
+		Expect(result.IsEmpty()).To(BeTrue())
+	})
+
+	Specify("ExpandedAABB", func() {
+		result := ExpandedAABB(box, 0.5)
+		Expect(result).To(HaveAABBBounds(-1.5, -2.5, -3.5, 1.5, 2.5, 3.5))
+	})
+
+	Specify("Mat4AABBTransformation", func() {
+		matrix := Mat4Prod(
+			TranslationMat4(1.0, 2.0, 3.0),
+			RotationMat4(Degrees(90.0), 0.0, 0.0, 1.0),
+		)
+		result := Mat4AABBTransformation(matrix, box)
+		Expect(result).To(HaveAABBBounds(-1.0, 1.0, 0.0, 3.0, 3.0, 6.0))
+
+		matrix = RotationMat4(Degrees(45.0), 0.0, 1.0, 0.0)
+		unit := NewAABB(NewVec3(-1.0, -1.0, -1.0), NewVec3(1.0, 1.0, 1.0))
+		result = Mat4AABBTransformation(matrix, unit)
+		Expect(result).To(HaveAABBBounds(-1.4142135623730951, -1.0, -1.4142135623730951, 1.4142135623730951, 1.0, 1.4142135623730951))
+
+		result = Mat4AABBTransformation(matrix, EmptyAABB())
+		Expect(result.IsEmpty()).To(BeTrue())
+	})
+
+	Specify("Mat4AABBTransformation with a projective matrix", func() {
+		matrix := NewMat4(
+			1.0, 0.0, 0.0, 0.0,
+			0.0, 1.0, 0.0, 0.0,
+			0.0, 0.0, 1.0, 0.0,
+			0.0, 0.0, 1.0, 0.0,
+		)
+		frustum := NewAABB(NewVec3(-1.0, -2.0, 1.0), NewVec3(1.0, 2.0, 2.0))
+		result := Mat4AABBTransformation(matrix, frustum)
+		Expect(result).To(HaveAABBBounds(-1.0, -2.0, 1.0, 1.0, 2.0, 1.0))
+
+		result = Mat4AABBTransformation(PerspectiveMat4(-1.0, 1.0, -1.0, 1.0, 1.0, 10.0), NewAABB(NewVec3(-1.0, -1.0, -10.0), NewVec3(1.0, 1.0, -1.0)))
+		Expect(result).To(HaveAABBBounds(-1.0, -1.0, -1.0, 1.0, 1.0, 1.0))
+	})
+
+	Specify("#Center", func() {
+		box = NewAABB(NewVec3(0.0, 1.0, 2.0), NewVec3(2.0, 5.0, 3.0))
+		Expect(box.Center()).To(HaveVec3Coords(1.0, 3.0, 2.5))
+	})
+
+	Specify("#Extent", func() {
+		box = NewAABB(NewVec3(0.0, 1.0, 2.0), NewVec3(2.0, 5.0, 3.0))
+		Expect(box.Extent()).To(HaveVec3Coords(1.0, 2.0, 0.5))
+	})
+
+	Specify("#Size", func() {
+		box = NewAABB(NewVec3(0.0, 1.0, 2.0), NewVec3(2.0, 5.0, 3.0))
+		Expect(box.Size()).To(HaveVec3Coords(2.0, 4.0, 1.0))
+	})
+
+	DescribeTable("#ContainsPoint",
+		func(point Vec3, expected bool) {
+			Expect(box.ContainsPoint(point)).To(Equal(expected))
+		},
+		Entry("center", NewVec3(0.0, 0.0, 0.0), true),
+		Entry("on face", NewVec3(1.0, 0.0, 0.0), true),
+		Entry("on corner", NewVec3(-1.0, -2.0, -3.0), true),
+		Entry("outside X", NewVec3(1.5, 0.0, 0.0), false),
+		Entry("outside Y", NewVec3(0.0, -2.5, 0.0), false),
+		Entry("outside Z", NewVec3(0.0, 0.0, 3.5), false),
+	)
+
+	DescribeTable("#ContainsAABB",
+		func(other AABB, expected bool) {
+			Expect(box.ContainsAABB(other)).To(Equal(expected))
+		},
+		Entry("inner box", NewAABB(NewVec3(-0.5, -0.5, -0.5), NewVec3(0.5, 0.5, 0.5)), true),
+		Entry("same box", NewAABB(NewVec3(-1.0, -2.0, -3.0), NewVec3(1.0, 2.0, 3.0)), true),
+		Entry("overlapping box", NewAABB(NewVec3(0.0, 0.0, 0.0), NewVec3(2.0, 2.0, 2.0)), false),
+		Entry("disjoint box", NewAABB(NewVec3(5.0, 5.0, 5.0), NewVec3(6.0, 6.0, 6.0)), false),
+		Entry("empty box", EmptyAABB(), true),
+	)
+
+	DescribeTable("#Intersects",
+		func(other AABB, expected bool) {
+			Expect(box.Intersects(other)).To(Equal(expected))
+			Expect(other.Intersects(box)).To(Equal(expected))
+		},
+		Entry("inner box", NewAABB(NewVec3(-0.5, -0.5, -0.5), NewVec3(0.5, 0.5, 0.5)), true),
+		Entry("overlapping box", NewAABB(NewVec3(0.0, 0.0, 0.0), NewVec3(2.0, 2.0, 2.0)), true),
+		Entry("touching box", NewAABB(NewVec3(1.0, 0.0, 0.0), NewVec3(2.0, 1.0, 1.0)), true),
+		Entry("disjoint box", NewAABB(NewVec3(5.0, 5.0, 5.0), NewVec3(6.0, 6.0, 6.0)), false),
+		Entry("empty box", EmptyAABB(), false),
+	)
+})
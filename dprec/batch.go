@@ -0,0 +1,140 @@
+package dprec
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MinBatchChunkSize is the minimum number of elements that the batch
+// functions assign to a single goroutine. Inputs that are smaller than
+// twice this size are always processed on the calling goroutine, since
+// the cost of starting goroutines would outweigh the gain.
+const MinBatchChunkSize = 4096
+
+// Mat4Vec4ProdBatch stores the product of the matrix and each vector of
+// src into the respective element of dst. It is equivalent to calling
+// Mat4Vec4Prod for each element.
+//
+// If workers is larger than one, the work is split across up to that many
+// goroutines (see MinBatchChunkSize) and the function returns once all of
+// them have completed. The dst slice may be the same as src but must not
+// otherwise overlap with it. The function panics if the length of dst is
+// less than the length of src, regardless of its capacity.
+func Mat4Vec4ProdBatch(dst, src []Vec4, mat Mat4, workers int) {
+	checkBatchLength(len(dst), len(src))
+	runBatch(len(src), workers, func(start, end int) {
+		chunk := dst[start:end]
+		for i, vec := range src[start:end] {
+			chunk[i] = Vec4{
+				X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13*vec.Z + mat.M14*vec.W,
+				Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23*vec.Z + mat.M24*vec.W,
+				Z: mat.M31*vec.X + mat.M32*vec.Y + mat.M33*vec.Z + mat.M34*vec.W,
+				W: mat.M41*vec.X + mat.M42*vec.Y + mat.M43*vec.Z + mat.M44*vec.W,
+			}
+		}
+	})
+}
+
+// Mat4Vec3TransformationBatch applies the 3D affine transformation to each
+// point of src and stores the result into the respective element of dst.
+// It is equivalent to calling Mat4Vec3Transformation for each element.
+//
+// The workers parameter and the slice requirements are the same as for
+// Mat4Vec4ProdBatch.
+func Mat4Vec3TransformationBatch(dst, src []Vec3, mat Mat4, workers int) {
+	checkBatchLength(len(dst), len(src))
+	runBatch(len(src), workers, func(start, end int) {
+		chunk := dst[start:end]
+		for i, vec := range src[start:end] {
+			chunk[i] = Vec3{
+				X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13*vec.Z + mat.M14,
+				Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23*vec.Z + mat.M24,
+				Z: mat.M31*vec.X + mat.M32*vec.Y + mat.M33*vec.Z + mat.M34,
+			}
+		}
+	})
+}
+
+// Mat4Vec3DirectionBatch applies the upper-left 3x3 part of the matrix to
+// each direction of src and stores the result into the respective element
+// of dst. The translation is not applied and the results are not
+// normalized.
+//
+// The workers parameter and the slice requirements are the same as for
+// Mat4Vec4ProdBatch.
+func Mat4Vec3DirectionBatch(dst, src []Vec3, mat Mat4, workers int) {
+	mat3Vec3ProdBatch(dst, src, NewMat3(
+		mat.M11, mat.M12, mat.M13,
+		mat.M21, mat.M22, mat.M23,
+		mat.M31, mat.M32, mat.M33,
+	), false, workers)
+}
+
+// Mat4Vec3NormalBatch transforms each surface normal of src with the
+// NormalMat3 of the matrix and stores the normalized result into the
+// respective element of dst.
+//
+// The workers parameter and the slice requirements are the same as for
+// Mat4Vec4ProdBatch. The behavior is undefined if the matrix is not
+// reversible.
+func Mat4Vec3NormalBatch(dst, src []Vec3, mat Mat4, workers int) {
+	mat3Vec3ProdBatch(dst, src, NormalMat3(mat), true, workers)
+}
+
+// QuatVec3RotationBatch rotates each vector of src by the quaternion and
+// stores the result into the respective element of dst. It is equivalent
+// to calling QuatVec3Rotation for each element, provided that the
+// quaternion is normalized.
+//
+// The workers parameter and the slice requirements are the same as for
+// Mat4Vec4ProdBatch.
+func QuatVec3RotationBatch(dst, src []Vec3, q Quat, workers int) {
+	mat3Vec3ProdBatch(dst, src, QuatToMat3(q), false, workers)
+}
+
+// mat3Vec3ProdBatch stores the product of the matrix and each vector of
+// src into the respective element of dst, optionally normalizing it.
+func mat3Vec3ProdBatch(dst, src []Vec3, mat Mat3, normalize bool, workers int) {
+	checkBatchLength(len(dst), len(src))
+	runBatch(len(src), workers, func(start, end int) {
+		chunk := dst[start:end]
+		for i, vec := range src[start:end] {
+			chunk[i] = Vec3{
+				X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13*vec.Z,
+				Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23*vec.Z,
+				Z: mat.M31*vec.X + mat.M32*vec.Y + mat.M33*vec.Z,
+			}
+		}
+		if normalize {
+			for i := range chunk {
+				chunk[i].Normalize()
+			}
+		}
+	})
+}
+
+func checkBatchLength(dstLength, srcLength int) {
+	if dstLength < srcLength {
+		panic(fmt.Sprintf("batch destination has %d elements but source has %d", dstLength, srcLength))
+	}
+}
+
+// runBatch calls fn for consecutive ranges that cover [0, count). The
+// ranges are processed concurrently when workers allows for more than one
+// chunk of at least MinBatchChunkSize elements.
+func runBatch(count, workers int, fn func(start, end int)) {
+	chunks := min(workers, count/MinBatchChunkSize)
+	if chunks < 2 {
+		fn(0, count)
+		return
+	}
+	var group sync.WaitGroup
+	for i := range chunks {
+		start := i * count / chunks
+		end := (i + 1) * count / chunks
+		group.Go(func() {
+			fn(start, end)
+		})
+	}
+	group.Wait()
+}
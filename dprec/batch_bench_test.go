@@ -0,0 +1,82 @@
+package dprec_test
+
+import (
+	"runtime"
+	"testing"
+
+	. "github.com/mokiat/gomath/dprec"
+)
+
+const benchmarkBatchSize = 256 * 1024
+
+func benchmarkBatchData() ([]Vec3, []Vec3, Mat4) {
+	src := make([]Vec3, benchmarkBatchSize)
+	for i := range src {
+		src[i] = NewVec3(float64(i%7), float64(i%5), float64(i%3))
+	}
+	dst := make([]Vec3, benchmarkBatchSize)
+	mat := Mat4Prod(
+		TranslationMat4(1.0, 2.0, 3.0),
+		RotationMat4(Degrees(30.0), 0.0, 1.0, 0.0),
+	)
+	return dst, src, mat
+}
+
+func BenchmarkMat4Vec3TransformationLoop(b *testing.B) {
+	dst, src, mat := benchmarkBatchData()
+	for b.Loop() {
+		for i, point := range src {
+			dst[i] = Mat4Vec3Transformation(mat, point)
+		}
+	}
+	reportBatchThroughput(b)
+}
+
+func BenchmarkMat4Vec3TransformationBatch(b *testing.B) {
+	dst, src, mat := benchmarkBatchData()
+	for b.Loop() {
+		Mat4Vec3TransformationBatch(dst, src, mat, 1)
+	}
+	reportBatchThroughput(b)
+}
+
+func BenchmarkMat4Vec3TransformationBatchParallel(b *testing.B) {
+	dst, src, mat := benchmarkBatchData()
+	for b.Loop() {
+		Mat4Vec3TransformationBatch(dst, src, mat, runtime.GOMAXPROCS(0))
+	}
+	reportBatchThroughput(b)
+}
+
+func BenchmarkQuatVec3RotationLoop(b *testing.B) {
+	dst, src, _ := benchmarkBatchData()
+	quat := RotationQuat(Degrees(30.0), BasisYVec3())
+	for b.Loop() {
+		for i, vector := range src {
+			dst[i] = QuatVec3Rotation(quat, vector)
+		}
+	}
+	reportBatchThroughput(b)
+}
+
+func BenchmarkQuatVec3RotationBatch(b *testing.B) {
+	dst, src, _ := benchmarkBatchData()
+	quat := RotationQuat(Degrees(30.0), BasisYVec3())
+	for b.Loop() {
+		QuatVec3RotationBatch(dst, src, quat, 1)
+	}
+	reportBatchThroughput(b)
+}
+
+func BenchmarkQuatVec3RotationBatchParallel(b *testing.B) {
+	dst, src, _ := benchmarkBatchData()
+	quat := RotationQuat(Degrees(30.0), BasisYVec3())
+	for b.Loop() {
+		QuatVec3RotationBatch(dst, src, quat, runtime.GOMAXPROCS(0))
+	}
+	reportBatchThroughput(b)
+}
+
+func reportBatchThroughput(b *testing.B) {
+	b.ReportMetric(float64(b.N*benchmarkBatchSize)/b.Elapsed().Seconds(), "vectors/s")
+}
@@ -0,0 +1,120 @@
+package dprec
+
+import "github.com/mokiat/gomath/gen"
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y.
+func (v Vec2) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Vec2[float64](v).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec2) MarshalBinary() ([]byte, error) {
+	return gen.Vec2[float64](v).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec2) UnmarshalBinary(data []byte) error {
+	return (*gen.Vec2[float64])(v).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y, Z.
+func (v Vec3) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Vec3[float64](v).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec3) MarshalBinary() ([]byte, error) {
+	return gen.Vec3[float64](v).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec3) UnmarshalBinary(data []byte) error {
+	return (*gen.Vec3[float64])(v).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y, Z, W.
+func (v Vec4) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Vec4[float64](v).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec4) MarshalBinary() ([]byte, error) {
+	return gen.Vec4[float64](v).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec4) UnmarshalBinary(data []byte) error {
+	return (*gen.Vec4[float64])(v).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The quaternion is
+// encoded as the little-endian components W, X, Y, Z, following the
+// argument order of NewQuat. Note that PackBuffer uses the X, Y, Z, W
+// order of shaders instead.
+func (q Quat) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Quat[float64](q).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (q Quat) MarshalBinary() ([]byte, error) {
+	return gen.Quat[float64](q).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (q *Quat) UnmarshalBinary(data []byte) error {
+	return (*gen.Quat[float64])(q).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat2) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Mat2[float64](m).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat2) MarshalBinary() ([]byte, error) {
+	return gen.Mat2[float64](m).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat2) UnmarshalBinary(data []byte) error {
+	return (*gen.Mat2[float64])(m).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat3) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Mat3[float64](m).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat3) MarshalBinary() ([]byte, error) {
+	return gen.Mat3[float64](m).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat3) UnmarshalBinary(data []byte) error {
+	return (*gen.Mat3[float64])(m).UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat4) AppendBinary(data []byte) ([]byte, error) {
+	return gen.Mat4[float64](m).AppendBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat4) MarshalBinary() ([]byte, error) {
+	return gen.Mat4[float64](m).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat4) UnmarshalBinary(data []byte) error {
+	return (*gen.Mat4[float64])(m).UnmarshalBinary(data)
+}
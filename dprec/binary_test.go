@@ -0,0 +1,126 @@
+package dprec_test
+
+import (
+	"encoding/binary"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("Binary", func() {
+	components := func(data []byte) []float64 {
+		result := make([]float64, len(data)/8)
+		for i := range result {
+			result[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+		return result
+	}
+
+	Specify("Vec2", func() {
+		data, err := NewVec2(1.0, 2.0).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components(data)).To(Equal([]float64{1.0, 2.0}))
+
+		var vec Vec2
+		Expect(vec.UnmarshalBinary(data)).To(Succeed())
+		Expect(vec).To(HaveVec2Coords(1.0, 2.0))
+	})
+
+	Specify("Vec3", func() {
+		data, err := NewVec3(1.0, 2.0, 3.0).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components(data)).To(Equal([]float64{1.0, 2.0, 3.0}))
+
+		var vec Vec3
+		Expect(vec.UnmarshalBinary(data)).To(Succeed())
+		Expect(vec).To(HaveVec3Coords(1.0, 2.0, 3.0))
+	})
+
+	Specify("Vec4", func() {
+		data, err := NewVec4(1.0, 2.0, 3.0, 4.0).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components(data)).To(Equal([]float64{1.0, 2.0, 3.0, 4.0}))
+
+		var vec Vec4
+		Expect(vec.UnmarshalBinary(data)).To(Succeed())
+		Expect(vec).To(HaveVec4Coords(1.0, 2.0, 3.0, 4.0))
+	})
+
+	Specify("Quat", func() {
+		data, err := NewQuat(1.0, 2.0, 3.0, 4.0).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(components(data)).To(Equal([]float64{1.0, 2.0, 3.0, 4.0}))
+
+		var quat Quat
+		Expect(quat.UnmarshalBinary(data)).To(Succeed())
+		Expect(quat).To(HaveQuatCoords(1.0, 2.0, 3.0, 4.0))
+	})
+
+	Specify("Mat2", func() {
+		matrix := NewMat2(
+			1.0, 2.0,
+			3.0, 4.0,
+		)
+		data, err := matrix.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		columnMajor := matrix.ColumnMajorArray()
+		Expect(components(data)).To(Equal(columnMajor[:]))
+
+		var result Mat2
+		Expect(result.UnmarshalBinary(data)).To(Succeed())
+		Expect(result).To(Equal(matrix))
+		Expect(result.UnmarshalBinary(data[:len(data)-1])).ToNot(Succeed())
+	})
+
+	Specify("Mat3", func() {
+		matrix := NewMat3(
+			1.0, 2.0, 3.0,
+			4.0, 5.0, 6.0,
+			7.0, 8.0, 9.0,
+		)
+		data, err := matrix.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		columnMajor := matrix.ColumnMajorArray()
+		Expect(components(data)).To(Equal(columnMajor[:]))
+
+		var result Mat3
+		Expect(result.UnmarshalBinary(data)).To(Succeed())
+		Expect(result).To(Equal(matrix))
+	})
+
+	Specify("Mat4", func() {
+		matrix := NewMat4(
+			1.0, 2.0, 3.0, 4.0,
+			5.0, 6.0, 7.0, 8.0,
+			9.0, 10.0, 11.0, 12.0,
+			13.0, 14.0, 15.0, 16.0,
+		)
+		data, err := matrix.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		columnMajor := matrix.ColumnMajorArray()
+		Expect(components(data)).To(Equal(columnMajor[:]))
+
+		var result Mat4
+		Expect(result.UnmarshalBinary(data)).To(Succeed())
+		Expect(result).To(Equal(matrix))
+	})
+
+	Specify("#AppendBinary", func() {
+		data, err := NewVec2(1.0, 2.0).AppendBinary([]byte{0xFF})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(17))
+		Expect(data[0]).To(Equal(byte(0xFF)))
+		Expect(components(data[1:])).To(Equal([]float64{1.0, 2.0}))
+	})
+
+	Specify("#UnmarshalBinary with wrong size", func() {
+		var vec Vec3
+		Expect(vec.UnmarshalBinary(make([]byte, 8))).To(MatchError("invalid Vec3 binary data: expected 24 bytes but got 8"))
+		var matrix Mat4
+		Expect(matrix.UnmarshalBinary(make([]byte, 64))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,132 @@
+package dprec
+
+import "fmt"
+
+// SingularMatrixError is returned by the checked inversion functions when
+// the absolute value of the determinant of the matrix is not larger than
+// the threshold.
+type SingularMatrixError struct {
+	Determinant float64
+	Threshold   float64
+}
+
+// Error returns a description of the error.
+func (e *SingularMatrixError) Error() string {
+	return fmt.Sprintf("matrix is singular: determinant %g is within threshold %g", e.Determinant, e.Threshold)
+}
+
+// ZeroLengthError is returned by the checked normalization functions when
+// the length of the vector or quaternion is not larger than the threshold.
+type ZeroLengthError struct {
+	Length    float64
+	Threshold float64
+}
+
+// Error returns a description of the error.
+func (e *ZeroLengthError) Error() string {
+	return fmt.Sprintf("cannot normalize: length %g is within threshold %g", e.Length, e.Threshold)
+}
+
+// CheckedInverseMat2 is like InverseMat2 but returns a SingularMatrixError
+// if the absolute value of the determinant is not larger than threshold
+// (e.g. Epsilon) or is NaN.
+func CheckedInverseMat2(m Mat2, threshold float64) (Mat2, error) {
+	determinant := m.Determinant()
+	if isWithinThreshold(determinant, threshold) {
+		return Mat2{}, &SingularMatrixError{
+			Determinant: determinant,
+			Threshold:   threshold,
+		}
+	}
+	return InverseMat2(m), nil
+}
+
+// CheckedInverseMat3 is like InverseMat3 but returns a SingularMatrixError
+// if the absolute value of the determinant is not larger than threshold
+// (e.g. Epsilon) or is NaN.
+func CheckedInverseMat3(m Mat3, threshold float64) (Mat3, error) {
+	determinant := m.Determinant()
+	if isWithinThreshold(determinant, threshold) {
+		return Mat3{}, &SingularMatrixError{
+			Determinant: determinant,
+			Threshold:   threshold,
+		}
+	}
+	return InverseMat3(m), nil
+}
+
+// CheckedInverseMat4 is like InverseMat4 but returns a SingularMatrixError
+// if the absolute value of the determinant is not larger than threshold
+// (e.g. Epsilon) or is NaN.
+func CheckedInverseMat4(m Mat4, threshold float64) (Mat4, error) {
+	determinant := m.Determinant()
+	if isWithinThreshold(determinant, threshold) {
+		return Mat4{}, &SingularMatrixError{
+			Determinant: determinant,
+			Threshold:   threshold,
+		}
+	}
+	return InverseMat4(m), nil
+}
+
+// CheckedUnitVec2 is like UnitVec2 but returns a ZeroLengthError if the
+// length of the vector is not larger than threshold (e.g. Epsilon) or is
+// NaN.
+func CheckedUnitVec2(vector Vec2, threshold float64) (Vec2, error) {
+	length := vector.Length()
+	if isWithinThreshold(length, threshold) {
+		return Vec2{}, &ZeroLengthError{
+			Length:    length,
+			Threshold: threshold,
+		}
+	}
+	return Vec2Quot(vector, length), nil
+}
+
+// CheckedUnitVec3 is like UnitVec3 but returns a ZeroLengthError if the
+// length of the vector is not larger than threshold (e.g. Epsilon) or is
+// NaN.
+func CheckedUnitVec3(vector Vec3, threshold float64) (Vec3, error) {
+	length := vector.Length()
+	if isWithinThreshold(length, threshold) {
+		return Vec3{}, &ZeroLengthError{
+			Length:    length,
+			Threshold: threshold,
+		}
+	}
+	return Vec3Quot(vector, length), nil
+}
+
+// CheckedUnitVec4 is like UnitVec4 but returns a ZeroLengthError if the
+// length of the vector is not larger than threshold (e.g. Epsilon) or is
+// NaN.
+func CheckedUnitVec4(vector Vec4, threshold float64) (Vec4, error) {
+	length := vector.Length()
+	if isWithinThreshold(length, threshold) {
+		return Vec4{}, &ZeroLengthError{
+			Length:    length,
+			Threshold: threshold,
+		}
+	}
+	return Vec4Quot(vector, length), nil
+}
+
+// CheckedUnitQuat is like UnitQuat but returns a ZeroLengthError if the
+// norm of the quaternion is not larger than threshold (e.g. Epsilon) or is
+// NaN.
+func CheckedUnitQuat(q Quat, threshold float64) (Quat, error) {
+	norm := q.Norm()
+	if isWithinThreshold(norm, threshold) {
+		return Quat{}, &ZeroLengthError{
+			Length:    norm,
+			Threshold: threshold,
+		}
+	}
+	return QuatScalarQuot(q, norm), nil
+}
+
+// isWithinThreshold returns true if the absolute value is not larger than
+// the threshold, which includes the case where the value is NaN.
+func isWithinThreshold(value, threshold float64) bool {
+	return !(Abs(value) > threshold)
+}
@@ -0,0 +1,247 @@
+package dprec
+
+import "fmt"
+
+// NewDualQuat creates a DualQuat with the given real and dual parts.
+func NewDualQuat(real, dual Quat) DualQuat {
+	return DualQuat{
+		Real: real,
+		Dual: dual,
+	}
+}
+
+// IdentityDualQuat returns the identity dual quaternion (no rotation and
+// no translation).
+func IdentityDualQuat() DualQuat {
+	return DualQuat{
+		Real: IdentityQuat(),
+	}
+}
+
+// RotationTranslationDualQuat returns the dual quaternion of the rigid
+// transform that applies rotation and then translation. The rotation needs
+// to be a unit quaternion.
+func RotationTranslationDualQuat(rotation Quat, translation Vec3) DualQuat {
+	return DualQuat{
+		Real: rotation,
+		Dual: QuatScalarProd(QuatProd(vec3Quat(translation), rotation), 0.5),
+	}
+}
+
+// TranslationDualQuat returns the dual quaternion of a translation.
+func TranslationDualQuat(translation Vec3) DualQuat {
+	return RotationTranslationDualQuat(IdentityQuat(), translation)
+}
+
+// Mat4ToDualQuat returns the dual quaternion of the rigid transform that
+// is represented by the matrix.
+//
+// NOTE: Dual quaternions cannot represent scale. The matrix is expected to
+// have identity scale, in the same way as for Mat4.Rotation.
+func Mat4ToDualQuat(m Mat4) DualQuat {
+	return RotationTranslationDualQuat(m.Rotation(), m.Translation())
+}
+
+// DualQuatSum returns the component-wise sum of two dual quaternions.
+func DualQuatSum(a, b DualQuat) DualQuat {
+	return DualQuat{
+		Real: quatSum(a.Real, b.Real),
+		Dual: quatSum(a.Dual, b.Dual),
+	}
+}
+
+// DualQuatScalarProd multiplies both parts of a dual quaternion by a scalar
+// value.
+func DualQuatScalarProd(d DualQuat, value float64) DualQuat {
+	return DualQuat{
+		Real: QuatScalarProd(d.Real, value),
+		Dual: QuatScalarProd(d.Dual, value),
+	}
+}
+
+// DualQuatProd returns the product of two dual quaternions. Like Mat4Prod,
+// the resulting transform applies second and then first.
+func DualQuatProd(first, second DualQuat) DualQuat {
+	return DualQuat{
+		Real: QuatProd(first.Real, second.Real),
+		Dual: quatSum(QuatProd(first.Real, second.Dual), QuatProd(first.Dual, second.Real)),
+	}
+}
+
+// ConjugateDualQuat returns the quaternion conjugate of the dual
+// quaternion, where both parts are conjugated.
+func ConjugateDualQuat(d DualQuat) DualQuat {
+	return DualQuat{
+		Real: ConjugateQuat(d.Real),
+		Dual: ConjugateQuat(d.Dual),
+	}
+}
+
+// DualConjugateDualQuat returns the dual conjugate of the dual quaternion,
+// where the dual part is negated.
+func DualConjugateDualQuat(d DualQuat) DualQuat {
+	return DualQuat{
+		Real: d.Real,
+		Dual: NegativeQuat(d.Dual),
+	}
+}
+
+// CombinedConjugateDualQuat returns the combined quaternion and dual
+// conjugate of the dual quaternion.
+func CombinedConjugateDualQuat(d DualQuat) DualQuat {
+	return DualQuat{
+		Real: ConjugateQuat(d.Real),
+		Dual: NegativeQuat(ConjugateQuat(d.Dual)),
+	}
+}
+
+// InverseDualQuat returns the multiplicative inverse of the dual
+// quaternion. For unit dual quaternions this is the same as
+// ConjugateDualQuat.
+func InverseDualQuat(d DualQuat) DualQuat {
+	invReal := InverseQuat(d.Real)
+	return DualQuat{
+		Real: invReal,
+		Dual: NegativeQuat(QuatProd(QuatProd(invReal, d.Dual), invReal)),
+	}
+}
+
+// UnitDualQuat returns the normalized dual quaternion. The real part is
+// scaled to unit length and the dual part is made orthogonal to it, which
+// is required for the result to represent a rigid transform.
+func UnitDualQuat(d DualQuat) DualQuat {
+	norm := d.Real.Norm()
+	real := QuatScalarQuot(d.Real, norm)
+	dual := QuatScalarQuot(d.Dual, norm)
+	return DualQuat{
+		Real: real,
+		Dual: quatSum(dual, QuatScalarProd(real, -QuatDot(real, dual))),
+	}
+}
+
+// DualQuatVec3Transformation transforms the point v by the unit dual
+// quaternion d, applying the rotation and then the translation.
+func DualQuatVec3Transformation(d DualQuat, v Vec3) Vec3 {
+	return Vec3Sum(QuatVec3Rotation(d.Real, v), d.Translation())
+}
+
+// DualQuatScLerp returns the screw linear interpolation between the unit
+// dual quaternions first and second using t. The rotation and the
+// translation are interpolated together along a screw motion with
+// constant speed, taking the shortest path.
+func DualQuatScLerp(first, second DualQuat, t float64) DualQuat {
+	if QuatDot(first.Real, second.Real) < 0.0 {
+		second = DualQuatScalarProd(second, -1.0)
+	}
+	diff := DualQuatProd(ConjugateDualQuat(first), second)
+	return DualQuatProd(first, dualQuatPow(diff, t))
+}
+
+// DualQuatBlend returns the dual quaternion linear blending (DLB) of the
+// specified unit dual quaternions with the respective weights, as used in
+// dual quaternion skinning. Quaternions on the opposite hemisphere to the
+// first one are negated, so that the shortest path is taken.
+//
+// The behavior is undefined if there are no quaternions or if the number
+// of weights does not match.
+func DualQuatBlend(quats []DualQuat, weights []float64) DualQuat {
+	var result DualQuat
+	for i, quat := range quats {
+		weight := weights[i]
+		if QuatDot(quats[0].Real, quat.Real) < 0.0 {
+			weight = -weight
+		}
+		result = DualQuatSum(result, DualQuatScalarProd(quat, weight))
+	}
+	return UnitDualQuat(result)
+}
+
+// DualQuat represents a dual quaternion Real + εDual. Unit dual quaternions
+// represent rigid transforms, which consist of a rotation and a
+// translation.
+type DualQuat struct {
+	Real Quat
+	Dual Quat
+}
+
+// IsNaN returns true if any component is NaN.
+func (d DualQuat) IsNaN() bool {
+	return d.Real.IsNaN() || d.Dual.IsNaN()
+}
+
+// IsInf returns true if any component is Inf.
+func (d DualQuat) IsInf() bool {
+	return d.Real.IsInf() || d.Dual.IsInf()
+}
+
+// IsIdentity returns true if this is the identity dual quaternion.
+func (d DualQuat) IsIdentity() bool {
+	return d.Real.IsIdentity() && Eq(d.Dual.SqrNorm(), 0.0)
+}
+
+// Rotation returns the rotation part of the rigid transform.
+func (d DualQuat) Rotation() Quat {
+	return d.Real
+}
+
+// Translation returns the translation part of the rigid transform.
+func (d DualQuat) Translation() Vec3 {
+	t := QuatProd(d.Dual, ConjugateQuat(d.Real))
+	return Vec3Prod(NewVec3(t.X, t.Y, t.Z), 2.0/d.Real.SqrNorm())
+}
+
+// Mat4 returns the transformation matrix of the rigid transform.
+func (d DualQuat) Mat4() Mat4 {
+	return TRSMat4(d.Translation(), d.Rotation(), NewVec3(1.0, 1.0, 1.0))
+}
+
+// TRS returns the translation, rotation and scale of the rigid transform,
+// in the same form as Mat4.TRS. The scale is always one.
+func (d DualQuat) TRS() (Vec3, Quat, Vec3) {
+	return d.Translation(), d.Rotation(), NewVec3(1.0, 1.0, 1.0)
+}
+
+// String returns a string representation of the dual quaternion.
+func (d DualQuat) String() string {
+	return fmt.Sprintf("(%s, %s)", d.Real, d.Dual)
+}
+
+// dualQuatPow raises the unit dual quaternion to the given power by
+// scaling the angle and the translation of its screw motion.
+func dualQuatPow(d DualQuat, pow float64) DualQuat {
+	// See "Geometric Skinning with Approximate Dual Quaternion Blending"
+	// by Kavan et al. for the screw parameters.
+	halfSin := NewVec3(d.Real.X, d.Real.Y, d.Real.Z).Length()
+	if halfSin < Epsilon {
+		// There is no rotation, so the translation is scaled directly.
+		return DualQuat{
+			Real: IdentityQuat(),
+			Dual: QuatScalarProd(d.Dual, pow),
+		}
+	}
+	halfCos := d.Real.W
+	axis := Vec3Quot(NewVec3(d.Real.X, d.Real.Y, d.Real.Z), halfSin)
+	pitch := -2.0 * d.Dual.W / halfSin
+	moment := Vec3Quot(Vec3Diff(NewVec3(d.Dual.X, d.Dual.Y, d.Dual.Z), Vec3Prod(axis, pitch*halfCos*0.5)), halfSin)
+
+	halfAngle := Atan2(halfSin, halfCos)
+	newHalfAngle := Radians(halfAngle.Radians() * pow)
+	newHalfSin := Sin(newHalfAngle)
+	newHalfCos := Cos(newHalfAngle)
+	newPitch := pitch * pow
+
+	real := Vec3Prod(axis, newHalfSin)
+	dual := Vec3Sum(Vec3Prod(moment, newHalfSin), Vec3Prod(axis, newPitch*0.5*newHalfCos))
+	return DualQuat{
+		Real: NewQuat(newHalfCos, real.X, real.Y, real.Z),
+		Dual: NewQuat(-newPitch*0.5*newHalfSin, dual.X, dual.Y, dual.Z),
+	}
+}
+
+func vec3Quat(v Vec3) Quat {
+	return NewQuat(0.0, v.X, v.Y, v.Z)
+}
+
+func quatSum(a, b Quat) Quat {
+	return NewQuat(a.W+b.W, a.X+b.X, a.Y+b.Y, a.Z+b.Z)
+}
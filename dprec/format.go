@@ -0,0 +1,53 @@
+package dprec
+
+import (
+	"fmt"
+
+	"github.com/mokiat/gomath/gen"
+)
+
+// Format implements fmt.Formatter. The %v, %s, %f, %F, %e, %E, %g and %G
+// verbs are applied to each component, along with the width, precision
+// and the ' ', '-' and '0' flags, where %v and %s behave like %f to match
+// String.
+//
+// The '+' flag produces a labelled form:
+//
+//	(X:1.000000 Y:2.000000)
+//
+// The %#v verb produces a Go-syntax form that can be pasted into code:
+//
+//	dprec.Vec2{X:1, Y:2}
+func (v Vec2) Format(state fmt.State, verb rune) {
+	gen.FormatVec2(state, verb, fmt.Sprintf("%T", v), gen.Vec2[float64](v))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (v Vec3) Format(state fmt.State, verb rune) {
+	gen.FormatVec3(state, verb, fmt.Sprintf("%T", v), gen.Vec3[float64](v))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (v Vec4) Format(state fmt.State, verb rune) {
+	gen.FormatVec4(state, verb, fmt.Sprintf("%T", v), gen.Vec4[float64](v))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (q Quat) Format(state fmt.State, verb rune) {
+	gen.FormatQuat(state, verb, fmt.Sprintf("%T", q), gen.Quat[float64](q))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (m Mat2) Format(state fmt.State, verb rune) {
+	gen.FormatMat2(state, verb, fmt.Sprintf("%T", m), gen.Mat2[float64](m))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (m Mat3) Format(state fmt.State, verb rune) {
+	gen.FormatMat3(state, verb, fmt.Sprintf("%T", m), gen.Mat3[float64](m))
+}
+
+// Format implements fmt.Formatter. See Vec2.Format for details.
+func (m Mat4) Format(state fmt.State, verb rune) {
+	gen.FormatMat4(state, verb, fmt.Sprintf("%T", m), gen.Mat4[float64](m))
+}
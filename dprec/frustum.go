@@ -0,0 +1,176 @@
+package dprec
+
+import (
+	"fmt"
+	"math"
+)
+
+// Containment specifies how a shape is positioned relative to a volume.
+type Containment uint8
+
+const (
+	// ContainmentOutside specifies that the shape is fully outside the
+	// volume.
+	ContainmentOutside Containment = iota
+
+	// ContainmentIntersecting specifies that the shape is partially inside
+	// the volume.
+	ContainmentIntersecting
+
+	// ContainmentInside specifies that the shape is fully inside the volume.
+	ContainmentInside
+)
+
+// ProjectionFrustum extracts the frustum of the specified projection or
+// view-projection matrix. The depth mapping needs to match the one that
+// was used to construct the matrix.
+//
+// If the matrix is only a projection one, the frustum is in view space. If
+// it is a combined view-projection matrix, the frustum is in world space.
+//
+// If the projection has an infinite far plane, the Far plane of the
+// frustum has a zero normal and a negative infinite distance, so that all
+// points are considered to be in front of it.
+func ProjectionFrustum(mat Mat4, mapping DepthMapping) Frustum {
+	// This is the Gribb-Hartmann method. A point is inside the frustum when
+	// its clip coordinates satisfy -W <= X <= W, -W <= Y <= W and either
+	// -W <= Z <= W or 0 <= Z <= W, depending on the depth range. Each of
+	// these inequalities is a plane equation in terms of the matrix rows.
+	row1 := mat.Row1()
+	row2 := mat.Row2()
+	row3 := mat.Row3()
+	row4 := mat.Row4()
+
+	lowerDepth := frustumPlane(row3)
+	if mapping.Range == DepthRangeNegativeOneToOne {
+		lowerDepth = frustumPlane(Vec4Sum(row4, row3))
+	}
+	upperDepth := frustumPlane(Vec4Diff(row4, row3))
+
+	near, far := lowerDepth, upperDepth
+	if mapping.Reversed {
+		near, far = far, near
+	}
+	return Frustum{
+		Left:   frustumPlane(Vec4Sum(row4, row1)),
+		Right:  frustumPlane(Vec4Diff(row4, row1)),
+		Bottom: frustumPlane(Vec4Sum(row4, row2)),
+		Top:    frustumPlane(Vec4Diff(row4, row2)),
+		Near:   near,
+		Far:    far,
+	}
+}
+
+func frustumPlane(equation Vec4) Plane {
+	if equation.X == 0.0 && equation.Y == 0.0 && equation.Z == 0.0 {
+		return Plane{
+			Distance: math.Inf(-1),
+		}
+	}
+	return EquationPlane(equation)
+}
+
+// Frustum represents a convex volume that is bounded by six planes. All
+// plane normals point towards the inside of the volume.
+type Frustum struct {
+	Left   Plane
+	Right  Plane
+	Bottom Plane
+	Top    Plane
+	Near   Plane
+	Far    Plane
+}
+
+// Planes returns the six planes of the frustum in the following order:
+// left, right, bottom, top, near, far.
+func (f Frustum) Planes() [6]Plane {
+	return [6]Plane{f.Left, f.Right, f.Bottom, f.Top, f.Near, f.Far}
+}
+
+// Corners returns the eight corner points of the frustum. The first four
+// points are on the near plane and the last four are on the far plane. Each
+// group is ordered as follows: bottom-left, bottom-right, top-left,
+// top-right.
+//
+// The second result is false if some of the corners do not exist, such as
+// when the frustum has an infinite far plane. Such corners are left zero.
+func (f Frustum) Corners() ([8]Vec3, bool) {
+	var result [8]Vec3
+	allOK := true
+	for i, depth := range [2]Plane{f.Near, f.Far} {
+		for j, sides := range [4][2]Plane{
+			{f.Bottom, f.Left},
+			{f.Bottom, f.Right},
+			{f.Top, f.Left},
+			{f.Top, f.Right},
+		} {
+			point, ok := PlaneIntersectionPoint(depth, sides[0], sides[1])
+			result[i*4+j] = point
+			allOK = allOK && ok
+		}
+	}
+	return result, allOK
+}
+
+// ContainsPoint returns true if the point is inside the frustum or on its
+// boundary.
+func (f Frustum) ContainsPoint(point Vec3) bool {
+	for _, plane := range f.Planes() {
+		if plane.SignedDistance(point) < 0.0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ClassifySphere returns how the sphere with the specified center and
+// radius is positioned relative to the frustum.
+func (f Frustum) ClassifySphere(center Vec3, radius float64) Containment {
+	result := ContainmentInside
+	for _, plane := range f.Planes() {
+		distance := plane.SignedDistance(center)
+		if distance < -radius {
+			return ContainmentOutside
+		}
+		if distance < radius {
+			result = ContainmentIntersecting
+		}
+	}
+	return result
+}
+
+// ClassifyAABB returns how the specified box is positioned relative to the
+// frustum.
+//
+// As with most frustum culling approaches, a box that is near a corner of
+// the frustum may be reported as intersecting even though it is outside.
+// This is conservative and is acceptable for culling purposes.
+func (f Frustum) ClassifyAABB(box AABB) Containment {
+	result := ContainmentInside
+	for _, plane := range f.Planes() {
+		// The positive vertex is the corner of the box that is furthest
+		// along the plane normal and the negative vertex is the opposite one.
+		positive, negative := box.Max, box.Min
+		if plane.Normal.X < 0.0 {
+			positive.X, negative.X = negative.X, positive.X
+		}
+		if plane.Normal.Y < 0.0 {
+			positive.Y, negative.Y = negative.Y, positive.Y
+		}
+		if plane.Normal.Z < 0.0 {
+			positive.Z, negative.Z = negative.Z, positive.Z
+		}
+		if plane.SignedDistance(positive) < 0.0 {
+			return ContainmentOutside
+		}
+		if plane.SignedDistance(negative) < 0.0 {
+			result = ContainmentIntersecting
+		}
+	}
+	return result
+}
+
+// String returns a string representation of the frustum.
+func (f Frustum) String() string {
+	return fmt.Sprintf("(%s, %s, %s, %s, %s, %s)", f.Left, f.Right, f.Bottom, f.Top, f.Near, f.Far)
+}
@@ -0,0 +1,141 @@
+package dprec
+
+import "github.com/mokiat/gomath/gen"
+
+// Add adds other to the vector in place.
+func (v *Vec2) Add(other Vec2) {
+	(*gen.Vec2[float64])(v).Add(gen.Vec2[float64](other))
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec2) Sub(other Vec2) {
+	(*gen.Vec2[float64])(v).Sub(gen.Vec2[float64](other))
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec2) Scale(value float64) {
+	(*gen.Vec2[float64])(v).Scale(value)
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec2) AddScaled(other Vec2, scale float64) {
+	(*gen.Vec2[float64])(v).AddScaled(gen.Vec2[float64](other), scale)
+}
+
+// Negate negates the vector in place.
+func (v *Vec2) Negate() {
+	(*gen.Vec2[float64])(v).Negate()
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec2) Normalize() {
+	(*gen.Vec2[float64])(v).Normalize()
+}
+
+// Add adds other to the vector in place.
+func (v *Vec3) Add(other Vec3) {
+	(*gen.Vec3[float64])(v).Add(gen.Vec3[float64](other))
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec3) Sub(other Vec3) {
+	(*gen.Vec3[float64])(v).Sub(gen.Vec3[float64](other))
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec3) Scale(value float64) {
+	(*gen.Vec3[float64])(v).Scale(value)
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec3) AddScaled(other Vec3, scale float64) {
+	(*gen.Vec3[float64])(v).AddScaled(gen.Vec3[float64](other), scale)
+}
+
+// Negate negates the vector in place.
+func (v *Vec3) Negate() {
+	(*gen.Vec3[float64])(v).Negate()
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec3) Normalize() {
+	(*gen.Vec3[float64])(v).Normalize()
+}
+
+// Add adds other to the vector in place.
+func (v *Vec4) Add(other Vec4) {
+	(*gen.Vec4[float64])(v).Add(gen.Vec4[float64](other))
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec4) Sub(other Vec4) {
+	(*gen.Vec4[float64])(v).Sub(gen.Vec4[float64](other))
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec4) Scale(value float64) {
+	(*gen.Vec4[float64])(v).Scale(value)
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec4) AddScaled(other Vec4, scale float64) {
+	(*gen.Vec4[float64])(v).AddScaled(gen.Vec4[float64](other), scale)
+}
+
+// Negate negates the vector in place.
+func (v *Vec4) Negate() {
+	(*gen.Vec4[float64])(v).Negate()
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec4) Normalize() {
+	(*gen.Vec4[float64])(v).Normalize()
+}
+
+// Mul sets the quaternion to QuatProd(q, other).
+func (q *Quat) Mul(other Quat) {
+	(*gen.Quat[float64])(q).Mul(gen.Quat[float64](other))
+}
+
+// Conjugate conjugates the quaternion in place.
+func (q *Quat) Conjugate() {
+	(*gen.Quat[float64])(q).Conjugate()
+}
+
+// Normalize scales the quaternion in place to unit norm.
+func (q *Quat) Normalize() {
+	(*gen.Quat[float64])(q).Normalize()
+}
+
+// Mul sets the matrix to Mat2Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat2) Mul(other *Mat2) {
+	(*gen.Mat2[float64])(m).Mul((*gen.Mat2[float64])(other))
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat2) Transpose() {
+	(*gen.Mat2[float64])(m).Transpose()
+}
+
+// Mul sets the matrix to Mat3Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat3) Mul(other *Mat3) {
+	(*gen.Mat3[float64])(m).Mul((*gen.Mat3[float64])(other))
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat3) Transpose() {
+	(*gen.Mat3[float64])(m).Transpose()
+}
+
+// Mul sets the matrix to Mat4Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat4) Mul(other *Mat4) {
+	(*gen.Mat4[float64])(m).Mul((*gen.Mat4[float64])(other))
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat4) Transpose() {
+	(*gen.Mat4[float64])(m).Transpose()
+}
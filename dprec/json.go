@@ -0,0 +1,103 @@
+package dprec
+
+import "github.com/mokiat/gomath/gen"
+
+// MarshalJSON implements json.Marshaler. The angle is encoded as a number
+// of radians.
+func (a Angle) MarshalJSON() ([]byte, error) {
+	return gen.MarshalAngleJSON(float64(a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from a number of
+// radians, a string with a "deg" or "rad" unit suffix (e.g. "90deg") is
+// accepted as well.
+func (a *Angle) UnmarshalJSON(data []byte) error {
+	return gen.UnmarshalAngleJSON(data, (*float64)(a))
+}
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y].
+func (v Vec2) MarshalJSON() ([]byte, error) {
+	return gen.Vec2[float64](v).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0} is accepted as well.
+func (v *Vec2) UnmarshalJSON(data []byte) error {
+	return (*gen.Vec2[float64])(v).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y, z].
+func (v Vec3) MarshalJSON() ([]byte, error) {
+	return gen.Vec3[float64](v).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0, "z": 0} is accepted as well.
+func (v *Vec3) UnmarshalJSON(data []byte) error {
+	return (*gen.Vec3[float64])(v).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y, z, w].
+func (v Vec4) MarshalJSON() ([]byte, error) {
+	return gen.Vec4[float64](v).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0, "z": 0, "w": 0} is accepted as well.
+func (v *Vec4) UnmarshalJSON(data []byte) error {
+	return (*gen.Vec4[float64])(v).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The quaternion is encoded as the
+// array [w, x, y, z], following the argument order of NewQuat.
+func (q Quat) MarshalJSON() ([]byte, error) {
+	return gen.Quat[float64](q).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"w": 1, "x": 0, "y": 0, "z": 0} is accepted as well.
+func (q *Quat) UnmarshalJSON(data []byte) error {
+	return (*gen.Quat[float64])(q).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of four elements in column-major order, matching ColumnMajorArray.
+func (m Mat2) MarshalJSON() ([]byte, error) {
+	return gen.Mat2[float64](m).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat2) UnmarshalJSON(data []byte) error {
+	return (*gen.Mat2[float64])(m).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of nine elements in column-major order, matching ColumnMajorArray.
+func (m Mat3) MarshalJSON() ([]byte, error) {
+	return gen.Mat3[float64](m).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat3) UnmarshalJSON(data []byte) error {
+	return (*gen.Mat3[float64])(m).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of sixteen elements in column-major order, matching ColumnMajorArray.
+func (m Mat4) MarshalJSON() ([]byte, error) {
+	return gen.Mat4[float64](m).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat4) UnmarshalJSON(data []byte) error {
+	return (*gen.Mat4[float64])(m).UnmarshalJSON(data)
+}
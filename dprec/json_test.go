@@ -0,0 +1,213 @@
+package dprec_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("JSON", func() {
+	Describe("Angle", func() {
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(Radians(1.5))).To(MatchJSON(`1.5`))
+		})
+
+		DescribeTable("#UnmarshalJSON",
+			func(data string, expected float64) {
+				var angle Angle
+				Expect(json.Unmarshal([]byte(data), &angle)).To(Succeed())
+				Expect(angle.Radians()).To(BeNumerically("~", expected, 0.000001))
+			},
+			Entry("number", `1.5`, float64(1.5)),
+			Entry("radians string", `"1.5rad"`, float64(1.5)),
+			Entry("degrees string", `"90deg"`, float64(math.Pi/2.0)),
+			Entry("negative degrees string", `" -45 deg "`, float64(-math.Pi/4.0)),
+		)
+
+		DescribeTable("#UnmarshalJSON errors",
+			func(data string) {
+				var angle Angle
+				Expect(json.Unmarshal([]byte(data), &angle)).ToNot(Succeed())
+			},
+			Entry("missing unit", `"90"`),
+			Entry("unknown unit", `"90grad"`),
+			Entry("invalid number", `"ninetydeg"`),
+			Entry("array", `[1.5]`),
+		)
+	})
+
+	Describe("Vec2", func() {
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(NewVec2(1.0, -2.5))).To(MatchJSON(`[1,-2.5]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var vec Vec2
+			Expect(json.Unmarshal([]byte(`[1,-2.5]`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec2Coords(1.0, -2.5))
+			Expect(json.Unmarshal([]byte(`{"x":3,"y":4}`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec2Coords(3.0, 4.0))
+		})
+	})
+
+	Describe("Vec3", func() {
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(NewVec3(0.1, 2.0, -3.0))).To(MatchJSON(`[0.1,2,-3]`))
+		})
+
+		Specify("#MarshalJSON with NaN", func() {
+			_, err := json.Marshal(NewVec3(math.NaN(), 0.0, 0.0))
+			Expect(err).To(HaveOccurred())
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var vec Vec3
+			Expect(json.Unmarshal([]byte(`[0.1,2,-3]`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec3Coords(0.1, 2.0, -3.0))
+			Expect(json.Unmarshal([]byte(`{"x":1,"z":3}`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		})
+
+		Specify("#UnmarshalJSON with null", func() {
+			vec := NewVec3(1.0, 2.0, 3.0)
+			Expect(json.Unmarshal([]byte(`null`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		})
+
+		DescribeTable("#UnmarshalJSON errors",
+			func(data string) {
+				var vec Vec3
+				Expect(json.Unmarshal([]byte(data), &vec)).ToNot(Succeed())
+			},
+			Entry("too few elements", `[1,2]`),
+			Entry("too many elements", `[1,2,3,4]`),
+			Entry("non-numeric element", `[1,"2",3]`),
+			Entry("unknown field", `{"x":1,"q":2}`),
+			Entry("number", `1`),
+		)
+	})
+
+	Describe("Vec4", func() {
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(NewVec4(1.0, 2.0, 3.0, 4.0))).To(MatchJSON(`[1,2,3,4]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var vec Vec4
+			Expect(json.Unmarshal([]byte(`[1,2,3,4]`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec4Coords(1.0, 2.0, 3.0, 4.0))
+			Expect(json.Unmarshal([]byte(`{"x":5,"y":6,"z":7,"w":8}`), &vec)).To(Succeed())
+			Expect(vec).To(HaveVec4Coords(5.0, 6.0, 7.0, 8.0))
+		})
+	})
+
+	Describe("Quat", func() {
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(NewQuat(1.0, 2.0, 3.0, 4.0))).To(MatchJSON(`[1,2,3,4]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var quat Quat
+			Expect(json.Unmarshal([]byte(`[1,2,3,4]`), &quat)).To(Succeed())
+			Expect(quat).To(HaveQuatCoords(1.0, 2.0, 3.0, 4.0))
+			Expect(json.Unmarshal([]byte(`{"w":5,"x":6,"y":7,"z":8}`), &quat)).To(Succeed())
+			Expect(quat).To(HaveQuatCoords(5.0, 6.0, 7.0, 8.0))
+		})
+	})
+
+	Describe("Mat2", func() {
+		var matrix Mat2
+
+		BeforeEach(func() {
+			matrix = NewMat2(
+				1.0, 2.0,
+				3.0, 4.0,
+			)
+		})
+
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(matrix)).To(MatchJSON(`[1,3,2,4]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var result Mat2
+			Expect(json.Unmarshal([]byte(`[1,3,2,4]`), &result)).To(Succeed())
+			Expect(result).To(Equal(matrix))
+			Expect(json.Unmarshal([]byte(`{"m12":10,"m21":20}`), &result)).To(Succeed())
+			Expect(result.M12).To(Equal(float64(10.0)))
+			Expect(result.M21).To(Equal(float64(20.0)))
+			Expect(json.Unmarshal([]byte(`[1,2,3]`), &result)).ToNot(Succeed())
+		})
+	})
+
+	Describe("Mat3", func() {
+		var matrix Mat3
+
+		BeforeEach(func() {
+			matrix = NewMat3(
+				1.0, 2.0, 3.0,
+				4.0, 5.0, 6.0,
+				7.0, 8.0, 9.0,
+			)
+		})
+
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(matrix)).To(MatchJSON(`[1,4,7,2,5,8,3,6,9]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var result Mat3
+			Expect(json.Unmarshal([]byte(`[1,4,7,2,5,8,3,6,9]`), &result)).To(Succeed())
+			Expect(result).To(Equal(matrix))
+			Expect(json.Unmarshal([]byte(`{"m11":10,"m23":20}`), &result)).To(Succeed())
+			Expect(result.M11).To(Equal(float64(10.0)))
+			Expect(result.M23).To(Equal(float64(20.0)))
+			Expect(json.Unmarshal([]byte(`[1,2,3,4]`), &result)).ToNot(Succeed())
+		})
+	})
+
+	Describe("Mat4", func() {
+		var matrix Mat4
+
+		BeforeEach(func() {
+			matrix = NewMat4(
+				1.0, 2.0, 3.0, 4.0,
+				5.0, 6.0, 7.0, 8.0,
+				9.0, 10.0, 11.0, 12.0,
+				13.0, 14.0, 15.0, 16.0,
+			)
+		})
+
+		Specify("#MarshalJSON", func() {
+			Expect(json.Marshal(matrix)).To(MatchJSON(`[1,5,9,13,2,6,10,14,3,7,11,15,4,8,12,16]`))
+		})
+
+		Specify("#UnmarshalJSON", func() {
+			var result Mat4
+			Expect(json.Unmarshal([]byte(`[1,5,9,13,2,6,10,14,3,7,11,15,4,8,12,16]`), &result)).To(Succeed())
+			Expect(result).To(Equal(matrix))
+			Expect(json.Unmarshal([]byte(`{"m14":-1}`), &result)).To(Succeed())
+			Expect(result.M14).To(Equal(float64(-1.0)))
+			Expect(json.Unmarshal([]byte(`[1,2,3]`), &result)).ToNot(Succeed())
+		})
+	})
+
+	Specify("nested in a struct", func() {
+		type transform struct {
+			Position Vec3  `json:"position"`
+			Rotation Quat  `json:"rotation"`
+			Angle    Angle `json:"angle"`
+		}
+		var value transform
+		Expect(json.Unmarshal([]byte(`{"position":[1,2,3],"rotation":{"w":1},"angle":"1.5rad"}`), &value)).To(Succeed())
+		Expect(value.Position).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(value.Rotation).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		Expect(value.Angle.Radians()).To(Equal(float64(1.5)))
+		Expect(json.Marshal(value)).To(MatchJSON(`{"position":[1,2,3],"rotation":[1,0,0,0],"angle":1.5}`))
+	})
+})
@@ -0,0 +1,127 @@
+package dprec
+
+import "github.com/mokiat/gomath/gen"
+
+// NewMat2 creates a Mat2 from the given row-major element values.
+func NewMat2(
+	m11, m12 float64,
+	m21, m22 float64,
+) Mat2 {
+	return Mat2(gen.NewMat2(m11, m12, m21, m22))
+}
+
+// ZeroMat2 returns a zero Mat2.
+func ZeroMat2() Mat2 {
+	return Mat2(gen.ZeroMat2[float64]())
+}
+
+// IdentityMat2 returns the identity Mat2.
+func IdentityMat2() Mat2 {
+	return Mat2(gen.IdentityMat2[float64]())
+}
+
+// TransposedMat2 returns the transpose of the given matrix.
+func TransposedMat2(m Mat2) Mat2 {
+	return Mat2(gen.TransposedMat2(gen.Mat2[float64](m)))
+}
+
+// ScaleMat2 returns a 2D scale matrix for the given scale factors.
+func ScaleMat2(x, y float64) Mat2 {
+	return Mat2(gen.ScaleMat2(x, y))
+}
+
+// RotationMat2 returns a 2D rotation matrix for the given angle.
+func RotationMat2(angle Angle) Mat2 {
+	return Mat2(gen.RotationMat2(angle.Radians()))
+}
+
+// InverseMat2 calculates the inverse of the matrix.
+//
+// The behavior is undefined if the matrix is not reversible
+// (i.e. has a zero determinant).
+func InverseMat2(m Mat2) Mat2 {
+	return Mat2(gen.InverseMat2(gen.Mat2[float64](m)))
+}
+
+// RowMajorArrayToMat2 creates a Mat2 from a row-major array.
+func RowMajorArrayToMat2(values [4]float64) Mat2 {
+	return Mat2(gen.RowMajorArrayToMat2(values))
+}
+
+// ColumnMajorArrayToMat2 creates a Mat2 from a column-major array.
+func ColumnMajorArrayToMat2(values [4]float64) Mat2 {
+	return Mat2(gen.ColumnMajorArrayToMat2(values))
+}
+
+// Mat2Prod returns the product of two matrices.
+func Mat2Prod(left, right Mat2) Mat2 {
+	return Mat2(gen.Mat2Prod(gen.Mat2[float64](left), gen.Mat2[float64](right)))
+}
+
+// Mat2MultiProd returns the product of multiple matrices.
+func Mat2MultiProd(first Mat2, others ...Mat2) Mat2 {
+	result := first
+	for _, matrix := range others {
+		result = Mat2Prod(result, matrix)
+	}
+	return result
+}
+
+// Mat2Vec2Prod multiplies a matrix by a Vec2.
+func Mat2Vec2Prod(mat Mat2, vec Vec2) Vec2 {
+	return Vec2(gen.Mat2Vec2Prod(gen.Mat2[float64](mat), gen.Vec2[float64](vec)))
+}
+
+// Mat2 is a 2x2 matrix with float64 components.
+// Fields use row-major notation: M<row><col>.
+type Mat2 gen.Mat2[float64]
+
+// IsNaN returns true if any component is NaN.
+func (m Mat2) IsNaN() bool {
+	return gen.Mat2[float64](m).IsNaN()
+}
+
+// IsInf returns true if any component is Inf.
+func (m Mat2) IsInf() bool {
+	return gen.Mat2[float64](m).IsInf()
+}
+
+// Row1 returns the first row as a Vec2.
+func (m Mat2) Row1() Vec2 {
+	return Vec2(gen.Mat2[float64](m).Row1())
+}
+
+// Row2 returns the second row as a Vec2.
+func (m Mat2) Row2() Vec2 {
+	return Vec2(gen.Mat2[float64](m).Row2())
+}
+
+// Column1 returns the first column as a Vec2.
+func (m Mat2) Column1() Vec2 {
+	return Vec2(gen.Mat2[float64](m).Column1())
+}
+
+// Column2 returns the second column as a Vec2.
+func (m Mat2) Column2() Vec2 {
+	return Vec2(gen.Mat2[float64](m).Column2())
+}
+
+// Determinant returns the determinant of the matrix.
+func (m Mat2) Determinant() float64 {
+	return gen.Mat2[float64](m).Determinant()
+}
+
+// RowMajorArray returns the matrix components in row-major order.
+func (m Mat2) RowMajorArray() [4]float64 {
+	return gen.Mat2[float64](m).RowMajorArray()
+}
+
+// ColumnMajorArray returns the matrix components in column-major order.
+func (m Mat2) ColumnMajorArray() [4]float64 {
+	return gen.Mat2[float64](m).ColumnMajorArray()
+}
+
+// String returns a string representation of the matrix.
+func (m Mat2) String() string {
+	return gen.Mat2[float64](m).String()
+}
@@ -0,0 +1,230 @@
+package dprec_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("Mat2", func() {
+	var matrix Mat2
+	var secondMatrix Mat2
+	var thirdMatrix Mat2
+	var vector Vec2
+
+	BeforeEach(func() {
+		matrix = NewMat2(
+			0.1, 0.2,
+			0.3, 0.4,
+		)
+		secondMatrix = NewMat2(
+			1.1, 1.2,
+			1.3, 1.4,
+		)
+		thirdMatrix = NewMat2(
+			2.1, 2.2,
+			2.3, 2.4,
+		)
+		vector = NewVec2(2.5, 3.5)
+	})
+
+	Specify("NewMat2", func() {
+		Expect(matrix).To(HaveMat2Elements(
+			0.1, 0.2,
+			0.3, 0.4,
+		))
+	})
+
+	Specify("ZeroMat2", func() {
+		Expect(ZeroMat2()).To(HaveMat2Elements(
+			0.0, 0.0,
+			0.0, 0.0,
+		))
+	})
+
+	Specify("IdentityMat2", func() {
+		identityMatrix := IdentityMat2()
+		transformedVector := Mat2Vec2Prod(identityMatrix, vector)
+		Expect(transformedVector).To(HaveVec2Coords(vector.X, vector.Y))
+	})
+
+	Specify("TransposedMat2", func() {
+		result := TransposedMat2(matrix)
+		Expect(result).To(HaveMat2Elements(
+			0.1, 0.3,
+			0.2, 0.4,
+		))
+	})
+
+	Specify("ScaleMat2", func() {
+		scaleMatrix := ScaleMat2(2.0, -3.0)
+		transformedVector := Mat2Vec2Prod(scaleMatrix, vector)
+		Expect(transformedVector).To(HaveVec2Coords(5.0, -10.5))
+	})
+
+	Specify("RotationMat2", func() {
+		rotationMatrix := RotationMat2(Degrees(30.0))
+		transformedVector := Mat2Vec2Prod(rotationMatrix, NewVec2(1.0, 0.0))
+		Expect(transformedVector).To(HaveVec2Coords(0.866025403784, 0.5))
+	})
+
+	Specify("InverseMat2", func() {
+		matrix := NewMat2(
+			4.0, 3.0,
+			1.1, 4.1,
+		)
+		inverseMatrix := InverseMat2(matrix)
+		productMatrix := Mat2Prod(inverseMatrix, matrix)
+		Expect(productMatrix).To(HaveMat2Elements(
+			1.0, 0.0,
+			0.0, 1.0,
+		))
+	})
+
+	Specify("RowMajorArrayToMat2", func() {
+		matrix := RowMajorArrayToMat2([4]float64{
+			1.0, 2.0,
+			3.0, 4.0,
+		})
+		Expect(matrix).To(HaveMat2Elements(
+			1.0, 2.0,
+			3.0, 4.0,
+		))
+	})
+
+	Specify("ColumnMajorArrayToMat2", func() {
+		matrix := ColumnMajorArrayToMat2([4]float64{
+			1.0, 2.0,
+			3.0, 4.0,
+		})
+		Expect(matrix).To(HaveMat2Elements(
+			1.0, 3.0,
+			2.0, 4.0,
+		))
+	})
+
+	Specify("Mat2Prod", func() {
+		result := Mat2Prod(matrix, secondMatrix)
+		Expect(result).To(HaveMat2Elements(
+			0.37, 0.4,
+			0.85, 0.92,
+		))
+	})
+
+	Specify("Mat2MultiProd", func() {
+		multiResult := Mat2MultiProd(
+			matrix,
+			secondMatrix,
+			thirdMatrix,
+		)
+		manualResult := matrix
+		manualResult = Mat2Prod(manualResult, secondMatrix)
+		manualResult = Mat2Prod(manualResult, thirdMatrix)
+		Expect(multiResult).To(HaveMat2Elements(
+			manualResult.M11, manualResult.M12,
+			manualResult.M21, manualResult.M22,
+		))
+	})
+
+	Specify("Mat2Vec2Prod", func() {
+		result := Mat2Vec2Prod(matrix, vector)
+		Expect(result).To(HaveVec2Coords(0.95, 2.15))
+	})
+
+	DescribeTable("#IsNaN",
+		func(mat Mat2, expected bool) {
+			Expect(mat.IsNaN()).To(Equal(expected))
+		},
+
+		Entry("standard floats", NewMat2(
+			1.0, 2.0,
+			3.0, 4.0,
+		), false),
+
+		Entry("M11 is +inf", Mat2{M11: math.Inf(1)}, false),
+		Entry("M12 is +inf", Mat2{M12: math.Inf(1)}, false),
+		Entry("M21 is +inf", Mat2{M21: math.Inf(1)}, false),
+		Entry("M22 is +inf", Mat2{M22: math.Inf(1)}, false),
+
+		Entry("M11 is -inf", Mat2{M11: math.Inf(-1)}, false),
+		Entry("M12 is -inf", Mat2{M12: math.Inf(-1)}, false),
+		Entry("M21 is -inf", Mat2{M21: math.Inf(-1)}, false),
+		Entry("M22 is -inf", Mat2{M22: math.Inf(-1)}, false),
+
+		Entry("M11 is NaN", Mat2{M11: math.NaN()}, true),
+		Entry("M12 is NaN", Mat2{M12: math.NaN()}, true),
+		Entry("M21 is NaN", Mat2{M21: math.NaN()}, true),
+		Entry("M22 is NaN", Mat2{M22: math.NaN()}, true),
+	)
+
+	DescribeTable("#IsInf",
+		func(mat Mat2, expected bool) {
+			Expect(mat.IsInf()).To(Equal(expected))
+		},
+
+		Entry("standard floats", NewMat2(
+			1.0, 2.0,
+			3.0, 4.0,
+		), false),
+
+		Entry("M11 is +inf", Mat2{M11: math.Inf(1)}, true),
+		Entry("M12 is +inf", Mat2{M12: math.Inf(1)}, true),
+		Entry("M21 is +inf", Mat2{M21: math.Inf(1)}, true),
+		Entry("M22 is +inf", Mat2{M22: math.Inf(1)}, true),
+
+		Entry("M11 is -inf", Mat2{M11: math.Inf(-1)}, true),
+		Entry("M12 is -inf", Mat2{M12: math.Inf(-1)}, true),
+		Entry("M21 is -inf", Mat2{M21: math.Inf(-1)}, true),
+		Entry("M22 is -inf", Mat2{M22: math.Inf(-1)}, true),
+
+		Entry("M11 is NaN", Mat2{M11: math.NaN()}, false),
+		Entry("M12 is NaN", Mat2{M12: math.NaN()}, false),
+		Entry("M21 is NaN", Mat2{M21: math.NaN()}, false),
+		Entry("M22 is NaN", Mat2{M22: math.NaN()}, false),
+	)
+
+	Specify("#Row1", func() {
+		Expect(matrix.Row1()).To(HaveVec2Coords(0.1, 0.2))
+	})
+
+	Specify("#Row2", func() {
+		Expect(matrix.Row2()).To(HaveVec2Coords(0.3, 0.4))
+	})
+
+	Specify("#Column1", func() {
+		Expect(matrix.Column1()).To(HaveVec2Coords(0.1, 0.3))
+	})
+
+	Specify("#Column2", func() {
+		Expect(matrix.Column2()).To(HaveVec2Coords(0.2, 0.4))
+	})
+
+	Specify("#Determinant", func() {
+		Expect(matrix.Determinant()).To(EqualFloat64(-0.02))
+	})
+
+	Specify("#RowMajorArray", func() {
+		array := matrix.RowMajorArray()
+		Expect(array[0]).To(EqualFloat64(0.1))
+		Expect(array[1]).To(EqualFloat64(0.2))
+		Expect(array[2]).To(EqualFloat64(0.3))
+		Expect(array[3]).To(EqualFloat64(0.4))
+	})
+
+	Specify("#ColumnMajorArray", func() {
+		array := matrix.ColumnMajorArray()
+		Expect(array[0]).To(EqualFloat64(0.1))
+		Expect(array[1]).To(EqualFloat64(0.3))
+		Expect(array[2]).To(EqualFloat64(0.2))
+		Expect(array[3]).To(EqualFloat64(0.4))
+	})
+
+	Specify("#String", func() {
+		result := matrix.String()
+		Expect(result).Should(Equal("((0.100000, 0.200000), (0.300000, 0.400000))"))
+	})
+})
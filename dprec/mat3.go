@@ -1,9 +1,6 @@
 package dprec
 
-import (
-	"fmt"
-	"math"
-)
+import "github.com/mokiat/gomath/gen"
 
 // NewMat3 creates a Mat3 from the given row-major element values.
 func NewMat3(
@@ -11,78 +8,82 @@ func NewMat3(
 	m21, m22, m23 float64,
 	m31, m32, m33 float64,
 ) Mat3 {
-	return Mat3{
-		M11: m11, M12: m12, M13: m13,
-		M21: m21, M22: m22, M23: m23,
-		M31: m31, M32: m32, M33: m33,
-	}
+	return Mat3(gen.NewMat3(m11, m12, m13, m21, m22, m23, m31, m32, m33))
 }
 
 // ZeroMat3 returns a zero Mat3.
 func ZeroMat3() Mat3 {
-	return Mat3{}
+	return Mat3(gen.ZeroMat3[float64]())
 }
 
 // IdentityMat3 returns the identity Mat3.
 func IdentityMat3() Mat3 {
-	var result Mat3
-	result.M11 = 1.0
-	result.M22 = 1.0
-	result.M33 = 1.0
-	return result
+	return Mat3(gen.IdentityMat3[float64]())
 }
 
 // TransposedMat3 returns the transpose of the given matrix.
 func TransposedMat3(m Mat3) Mat3 {
-	return NewMat3(
-		m.M11, m.M21, m.M31,
-		m.M12, m.M22, m.M32,
-		m.M13, m.M23, m.M33,
-	)
+	return Mat3(gen.TransposedMat3(gen.Mat3[float64](m)))
 }
 
 // TranslationMat3 returns a 2D translation matrix for the given offsets.
 func TranslationMat3(x, y float64) Mat3 {
-	result := IdentityMat3()
-	result.M13 = x
-	result.M23 = y
-	return result
+	return Mat3(gen.TranslationMat3(x, y))
 }
 
 // ScaleMat3 returns a 2D scale matrix for the given scale factors.
 func ScaleMat3(x, y float64) Mat3 {
-	var result Mat3
-	result.M11 = x
-	result.M22 = y
-	result.M33 = 1.0
-	return result
+	return Mat3(gen.ScaleMat3(x, y))
 }
 
 // RotationMat3 returns a 2D rotation matrix for the given angle.
 func RotationMat3(angle Angle) Mat3 {
-	cs := Cos(angle)
-	sn := Sin(angle)
-
-	var result Mat3
-	result.M11 = cs
-	result.M12 = -sn
-	result.M21 = sn
-	result.M22 = cs
-	result.M33 = 1.0
-	return result
+	return Mat3(gen.RotationMat3(angle.Radians()))
 }
 
-// OrthoMat3 returns a 2D orthographic projection matrix.
-func OrthoMat3(left, right, top, bottom float64) Mat3 {
-	var result Mat3
-	result.M11 = 2.0 / (right - left)
-	result.M13 = (right + left) / (left - right)
+// DiagonalMat3 returns a 3D linear scale matrix for the given scale
+// factors, which has them as its diagonal elements.
+func DiagonalMat3(x, y, z float64) Mat3 {
+	return Mat3(gen.DiagonalMat3(x, y, z))
+}
 
-	result.M22 = 2.0 / (top - bottom)
-	result.M23 = (top + bottom) / (bottom - top)
+// AxisAngleMat3 returns a 3D linear rotation matrix for the given angle
+// around the axis direction.
+func AxisAngleMat3(angle Angle, axis Vec3) Mat3 {
+	return Mat3(gen.AxisAngleMat3(angle.Radians(), gen.Vec3[float64](axis)))
+}
 
-	result.M33 = 1.0
-	return result
+// QuatToMat3 returns the 3D linear rotation matrix that is equivalent to
+// the specified quaternion.
+func QuatToMat3(q Quat) Mat3 {
+	return Mat3(gen.QuatToMat3(gen.Quat[float64](q)))
+}
+
+// OrientationMat3 builds a 3D linear orientation matrix from three axis
+// vectors, which become its columns.
+func OrientationMat3(orientX, orientY, orientZ Vec3) Mat3 {
+	return Mat3(gen.OrientationMat3(gen.Vec3[float64](orientX), gen.Vec3[float64](orientY), gen.Vec3[float64](orientZ)))
+}
+
+// NormalMat3 returns the matrix that transforms surface normals for the
+// specified transformation matrix. This is the inverse transpose of the
+// upper-left 3x3 part of the matrix, which keeps normals perpendicular to
+// surfaces even with non-uniform scale. The result is not normalized.
+//
+// The behavior is undefined if the matrix is not reversible.
+func NormalMat3(m Mat4) Mat3 {
+	return Mat3(gen.NormalMat3(gen.Mat4[float64](m)))
+}
+
+// Vec3OuterProd returns the outer product of two vectors, which is the
+// matrix with elements a[row] * b[column].
+func Vec3OuterProd(a, b Vec3) Mat3 {
+	return Mat3(gen.Vec3OuterProd(gen.Vec3[float64](a), gen.Vec3[float64](b)))
+}
+
+// OrthoMat3 returns a 2D orthographic projection matrix.
+func OrthoMat3(left, right, top, bottom float64) Mat3 {
+	return Mat3(gen.OrthoMat3(left, right, top, bottom))
 }
 
 // FastInverseMat3 calculates the inverse of the matrix with a few caveats.
@@ -93,13 +94,7 @@ func OrthoMat3(left, right, top, bottom float64) Mat3 {
 // For all other scenarios (e.g. a scale transformation was used), the InverseMat3 method should be
 // used instead, though it will be slower.
 func FastInverseMat3(m Mat3) Mat3 {
-	inverseTranslate := TranslationMat3(-m.M13, -m.M23)
-	inverseRotate := NewMat3(
-		m.M11, m.M21, 0.0,
-		m.M12, m.M22, 0.0,
-		0.0, 0.0, 1.0,
-	)
-	return Mat3Prod(inverseRotate, inverseTranslate)
+	return Mat3(gen.FastInverseMat3(gen.Mat3[float64](m)))
 }
 
 // InverseMat3 calculates the inverse of the matrix.
@@ -107,76 +102,35 @@ func FastInverseMat3(m Mat3) Mat3 {
 // The behavior is undefined if the matrix is not reversible
 // (i.e. has a zero determinant).
 func InverseMat3(m Mat3) Mat3 {
-	minor11 := m.M22*m.M33 - m.M23*m.M32
-	minor12 := m.M21*m.M33 - m.M23*m.M31
-	minor13 := m.M21*m.M32 - m.M22*m.M31
-
-	minor21 := m.M12*m.M33 - m.M13*m.M32
-	minor22 := m.M11*m.M33 - m.M13*m.M31
-	minor23 := m.M11*m.M32 - m.M12*m.M31
-
-	minor31 := m.M12*m.M23 - m.M13*m.M22
-	minor32 := m.M11*m.M23 - m.M13*m.M21
-	minor33 := m.M11*m.M22 - m.M12*m.M21
-
-	determinant := m.M11*minor11 - m.M12*minor12 + m.M13*minor13
+	return Mat3(gen.InverseMat3(gen.Mat3[float64](m)))
+}
 
-	return NewMat3(
-		+minor11/determinant, -minor21/determinant, +minor31/determinant,
-		-minor12/determinant, +minor22/determinant, -minor32/determinant,
-		+minor13/determinant, -minor23/determinant, +minor33/determinant,
-	)
+// AdjugateMat3 returns the adjugate (the transpose of the cofactor matrix)
+// of the given matrix. Unlike the inverse, it is defined for singular
+// matrices as well.
+func AdjugateMat3(m Mat3) Mat3 {
+	return Mat3(gen.AdjugateMat3(gen.Mat3[float64](m)))
 }
 
 // TransformationMat3 builds a 2D transformation matrix from orientation
 // vectors and a translation.
 func TransformationMat3(orientX, orientY, translation Vec2) Mat3 {
-	var result Mat3
-	result.M11 = orientX.X
-	result.M12 = orientY.X
-	result.M13 = translation.X
-
-	result.M21 = orientX.Y
-	result.M22 = orientY.Y
-	result.M23 = translation.Y
-
-	result.M33 = 1.0
-	return result
+	return Mat3(gen.TransformationMat3(gen.Vec2[float64](orientX), gen.Vec2[float64](orientY), gen.Vec2[float64](translation)))
 }
 
 // RowMajorArrayToMat3 creates a Mat3 from a row-major array.
 func RowMajorArrayToMat3(values [9]float64) Mat3 {
-	return Mat3{
-		M11: values[0], M12: values[1], M13: values[2],
-		M21: values[3], M22: values[4], M23: values[5],
-		M31: values[6], M32: values[7], M33: values[8],
-	}
+	return Mat3(gen.RowMajorArrayToMat3(values))
 }
 
 // ColumnMajorArrayToMat3 creates a Mat3 from a column-major array.
 func ColumnMajorArrayToMat3(values [9]float64) Mat3 {
-	return Mat3{
-		M11: values[0], M12: values[3], M13: values[6],
-		M21: values[1], M22: values[4], M23: values[7],
-		M31: values[2], M32: values[5], M33: values[8],
-	}
+	return Mat3(gen.ColumnMajorArrayToMat3(values))
 }
 
 // Mat3Prod returns the product of two matrices.
 func Mat3Prod(left, right Mat3) Mat3 {
-	return Mat3{
-		M11: left.M11*right.M11 + left.M12*right.M21 + left.M13*right.M31,
-		M12: left.M11*right.M12 + left.M12*right.M22 + left.M13*right.M32,
-		M13: left.M11*right.M13 + left.M12*right.M23 + left.M13*right.M33,
-
-		M21: left.M21*right.M11 + left.M22*right.M21 + left.M23*right.M31,
-		M22: left.M21*right.M12 + left.M22*right.M22 + left.M23*right.M32,
-		M23: left.M21*right.M13 + left.M22*right.M23 + left.M23*right.M33,
-
-		M31: left.M31*right.M11 + left.M32*right.M21 + left.M33*right.M31,
-		M32: left.M31*right.M12 + left.M32*right.M22 + left.M33*right.M32,
-		M33: left.M31*right.M13 + left.M32*right.M23 + left.M33*right.M33,
-	}
+	return Mat3(gen.Mat3Prod(gen.Mat3[float64](left), gen.Mat3[float64](right)))
 }
 
 // Mat3MultiProd returns the product of multiple matrices.
@@ -190,111 +144,102 @@ func Mat3MultiProd(first Mat3, others ...Mat3) Mat3 {
 
 // Mat3Vec3Prod multiplies a matrix by a Vec3.
 func Mat3Vec3Prod(mat Mat3, vec Vec3) Vec3 {
-	return Vec3{
-		X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13*vec.Z,
-		Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23*vec.Z,
-		Z: mat.M31*vec.X + mat.M32*vec.Y + mat.M33*vec.Z,
-	}
+	return Vec3(gen.Mat3Vec3Prod(gen.Mat3[float64](mat), gen.Vec3[float64](vec)))
 }
 
 // Mat3Vec2Transformation applies the 2D affine transformation to a Vec2.
 func Mat3Vec2Transformation(mat Mat3, vec Vec2) Vec2 {
-	return Vec2{
-		X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13,
-		Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23,
-	}
+	return Vec2(gen.Mat3Vec2Transformation(gen.Mat3[float64](mat), gen.Vec2[float64](vec)))
 }
 
 // Mat3 is a 3x3 matrix with float64 components.
 // Fields use row-major notation: M<row><col>.
-type Mat3 struct {
-	M11, M12, M13 float64
-	M21, M22, M23 float64
-	M31, M32, M33 float64
-}
+//
+// It is used both as a 2D affine transformation (e.g. TranslationMat3,
+// RotationMat3) and as a 3D linear transformation (e.g. AxisAngleMat3,
+// QuatToMat3).
+type Mat3 gen.Mat3[float64]
 
 // IsNaN returns true if any component is NaN.
 func (m Mat3) IsNaN() bool {
-	return math.IsNaN(m.M11) || math.IsNaN(m.M12) || math.IsNaN(m.M13) ||
-		math.IsNaN(m.M21) || math.IsNaN(m.M22) || math.IsNaN(m.M23) ||
-		math.IsNaN(m.M31) || math.IsNaN(m.M32) || math.IsNaN(m.M33)
+	return gen.Mat3[float64](m).IsNaN()
 }
 
 // IsInf returns true if any component is Inf.
 func (m Mat3) IsInf() bool {
-	return math.IsInf(m.M11, 0) || math.IsInf(m.M12, 0) || math.IsInf(m.M13, 0) ||
-		math.IsInf(m.M21, 0) || math.IsInf(m.M22, 0) || math.IsInf(m.M23, 0) ||
-		math.IsInf(m.M31, 0) || math.IsInf(m.M32, 0) || math.IsInf(m.M33, 0)
+	return gen.Mat3[float64](m).IsInf()
 }
 
 // Row1 returns the first row as a Vec3.
 func (m Mat3) Row1() Vec3 {
-	return NewVec3(m.M11, m.M12, m.M13)
+	return Vec3(gen.Mat3[float64](m).Row1())
 }
 
 // Row2 returns the second row as a Vec3.
 func (m Mat3) Row2() Vec3 {
-	return NewVec3(m.M21, m.M22, m.M23)
+	return Vec3(gen.Mat3[float64](m).Row2())
 }
 
 // Row3 returns the third row as a Vec3.
 func (m Mat3) Row3() Vec3 {
-	return NewVec3(m.M31, m.M32, m.M33)
+	return Vec3(gen.Mat3[float64](m).Row3())
 }
 
 // Column1 returns the first column as a Vec3.
 func (m Mat3) Column1() Vec3 {
-	return NewVec3(m.M11, m.M21, m.M31)
+	return Vec3(gen.Mat3[float64](m).Column1())
 }
 
 // Column2 returns the second column as a Vec3.
 func (m Mat3) Column2() Vec3 {
-	return NewVec3(m.M12, m.M22, m.M32)
+	return Vec3(gen.Mat3[float64](m).Column2())
 }
 
 // Column3 returns the third column as a Vec3.
 func (m Mat3) Column3() Vec3 {
-	return NewVec3(m.M13, m.M23, m.M33)
+	return Vec3(gen.Mat3[float64](m).Column3())
 }
 
 // OrientationX returns the X orientation vector of the matrix.
 func (m Mat3) OrientationX() Vec2 {
-	return NewVec2(m.M11, m.M21)
+	return Vec2(gen.Mat3[float64](m).OrientationX())
 }
 
 // OrientationY returns the Y orientation vector of the matrix.
 func (m Mat3) OrientationY() Vec2 {
-	return NewVec2(m.M12, m.M22)
+	return Vec2(gen.Mat3[float64](m).OrientationY())
 }
 
 // Translation returns the translation vector of the matrix.
 func (m Mat3) Translation() Vec2 {
-	return NewVec2(m.M13, m.M23)
+	return Vec2(gen.Mat3[float64](m).Translation())
+}
+
+// Determinant returns the determinant of the matrix.
+func (m Mat3) Determinant() float64 {
+	return gen.Mat3[float64](m).Determinant()
+}
+
+// Rotation returns the rotation that is represented by this 3D linear
+// matrix.
+//
+// NOTE: This function assumes that the matrix is orthonormal (i.e. has
+// identity scale).
+func (m Mat3) Rotation() Quat {
+	return Quat(gen.Mat3[float64](m).Rotation())
 }
 
 // RowMajorArray returns the matrix components in row-major order.
 func (m Mat3) RowMajorArray() [9]float64 {
-	return [9]float64{
-		m.M11, m.M12, m.M13,
-		m.M21, m.M22, m.M23,
-		m.M31, m.M32, m.M33,
-	}
+	return gen.Mat3[float64](m).RowMajorArray()
 }
 
 // ColumnMajorArray returns the matrix components in column-major order.
 func (m Mat3) ColumnMajorArray() [9]float64 {
-	return [9]float64{
-		m.M11, m.M21, m.M31,
-		m.M12, m.M22, m.M32,
-		m.M13, m.M23, m.M33,
-	}
+	return gen.Mat3[float64](m).ColumnMajorArray()
 }
 
 // String returns a string representation of the matrix.
 func (m Mat3) String() string {
-	return fmt.Sprintf("((%f, %f, %f), (%f, %f, %f), (%f, %f, %f))",
-		m.M11, m.M12, m.M13,
-		m.M21, m.M22, m.M23,
-		m.M31, m.M32, m.M33,
-	)
+	return gen.Mat3[float64](m).String()
 }
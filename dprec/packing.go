@@ -0,0 +1,464 @@
+package dprec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// BufferLayout specifies the rules that are used to place values in a GPU
+// buffer.
+//
+// The vector, quaternion and matrix types of this package are packed as
+// the GLSL types of matching precision (e.g. Vec3 as vec3 or dvec3). Scalar
+// fields are packed according to their Go kind: floating point values as
+// float or double depending on their size, int32 as int, uint32 as uint
+// and bool as a four-byte bool.
+type BufferLayout uint8
+
+const (
+	// BufferLayoutStd140 follows the std140 rules of GLSL, which are used
+	// for uniform buffers. The alignment and stride of arrays, matrix
+	// columns and structs are rounded up to 16 bytes.
+	BufferLayoutStd140 BufferLayout = iota
+
+	// BufferLayoutStd430 follows the std430 rules of GLSL, which are used
+	// for storage buffers. They are the same as std140 except that arrays
+	// and structs are not rounded up to 16 bytes.
+	BufferLayoutStd430
+
+	// BufferLayoutScalar follows the scalar block layout rules, where every
+	// value is aligned to the size of its components. Array elements are
+	// only padded as much as needed to keep each of them aligned.
+	BufferLayoutScalar
+)
+
+// String returns the GLSL name of the layout.
+func (l BufferLayout) String() string {
+	switch l {
+	case BufferLayoutStd140:
+		return "std140"
+	case BufferLayoutStd430:
+		return "std430"
+	case BufferLayoutScalar:
+		return "scalar"
+	default:
+		return fmt.Sprintf("BufferLayout(%d)", uint8(l))
+	}
+}
+
+// PackBuffer encodes the specified value according to the layout rules
+// and returns the resulting little-endian bytes.
+//
+// The value can be a struct, an array or a pointer to one of them, where
+// struct fields and array elements can be of the vector, quaternion and
+// matrix types of this package, of scalar types or of nested structs and
+// arrays. Vectors and quaternions are packed with their X, Y, Z, W
+// components in that order, which for quaternions matches the convention
+// of shaders but differs from the W, X, Y, Z order of MarshalBinary.
+// Matrices are packed in column-major order.
+// Blank fields can be used for explicit padding and are filled with zeros.
+func PackBuffer(layout BufferLayout, value any) ([]byte, error) {
+	return AppendPackedBuffer(nil, layout, value)
+}
+
+// AppendPackedBuffer is like PackBuffer except that the encoded value is
+// appended to data. Offsets are relative to the start of the appended
+// bytes, so the length of data should be a multiple of the alignment of
+// the value.
+func AppendPackedBuffer(data []byte, layout BufferLayout, value any) ([]byte, error) {
+	target, packed, err := inspectPackedValue(layout, value)
+	if err != nil {
+		return nil, err
+	}
+	start := len(data)
+	data = append(data, make([]byte, packed.size)...)
+	packed.write(data[start:], target)
+	return data, nil
+}
+
+// BufferLayoutReport describes how a value is placed in a GPU buffer. It
+// is intended for debugging offset mismatches between Go and shader code.
+type BufferLayoutReport struct {
+	Layout    BufferLayout
+	Type      string
+	Size      int
+	Alignment int
+	Entries   []BufferLayoutEntry
+}
+
+// String returns a table representation of the report.
+func (r BufferLayoutReport) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s layout of %s (size %d, alignment %d)\n", r.Layout, r.Type, r.Size, r.Alignment)
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(writer, "offset\tsize\talign\tstride\t")
+	for _, entry := range r.Entries {
+		fmt.Fprintf(writer, "%d\t%d\t%d\t%d\t  %s %s\n", entry.Offset, entry.Size, entry.Alignment, entry.Stride, entry.Type, entry.Path)
+	}
+	writer.Flush()
+	return builder.String()
+}
+
+// BufferLayoutEntry describes the placement of a single struct field or
+// array element of a packed value.
+type BufferLayoutEntry struct {
+	// Path identifies the entry (e.g. "Lights[1].Color").
+	Path string
+
+	// Type is the GLSL type of the entry (e.g. "vec3" or "float[4]").
+	Type string
+
+	// Offset is the position of the entry in bytes, relative to the start
+	// of the packed value.
+	Offset int
+
+	// Size is the number of bytes that the entry occupies, including any
+	// trailing padding.
+	Size int
+
+	// Alignment is the byte alignment of the entry.
+	Alignment int
+
+	// Stride is the distance in bytes between consecutive array elements
+	// or matrix columns and is zero for all other types.
+	Stride int
+}
+
+// InspectBufferLayout returns a report of how PackBuffer places the
+// specified value according to the layout rules.
+func InspectBufferLayout(layout BufferLayout, value any) (BufferLayoutReport, error) {
+	target, packed, err := inspectPackedValue(layout, value)
+	if err != nil {
+		return BufferLayoutReport{}, err
+	}
+	report := BufferLayoutReport{
+		Layout:    layout,
+		Type:      target.Type().String(),
+		Size:      packed.size,
+		Alignment: packed.alignment,
+	}
+	packed.report(&report, "", 0)
+	return report, nil
+}
+
+type packedKind uint8
+
+const (
+	packedKindScalar packedKind = iota
+	packedKindMatrix
+	packedKindArray
+	packedKindStruct
+)
+
+type packedType struct {
+	kind      packedKind
+	name      string
+	size      int
+	alignment int
+	stride    int
+	elem      *packedType
+	length    int
+	fields    []packedField
+
+	// componentSize and columns are only set for vectors and matrices.
+	componentSize int
+	columns       [][]int
+}
+
+type packedField struct {
+	name   string
+	offset int
+	packed *packedType
+}
+
+type packedTypeKey struct {
+	layout BufferLayout
+	typ    reflect.Type
+}
+
+// packedShapes lists the field names of the columns of the types that are
+// packed as GLSL vectors and matrices.
+var packedShapes = map[reflect.Type][][]string{
+	reflect.TypeFor[Vec2](): {{"X", "Y"}},
+	reflect.TypeFor[Vec3](): {{"X", "Y", "Z"}},
+	reflect.TypeFor[Vec4](): {{"X", "Y", "Z", "W"}},
+	reflect.TypeFor[Quat](): {{"X", "Y", "Z", "W"}},
+	reflect.TypeFor[Mat2](): {
+		{"M11", "M21"},
+		{"M12", "M22"},
+	},
+	reflect.TypeFor[Mat3](): {
+		{"M11", "M21", "M31"},
+		{"M12", "M22", "M32"},
+		{"M13", "M23", "M33"},
+	},
+	reflect.TypeFor[Mat4](): {
+		{"M11", "M21", "M31", "M41"},
+		{"M12", "M22", "M32", "M42"},
+		{"M13", "M23", "M33", "M43"},
+		{"M14", "M24", "M34", "M44"},
+	},
+}
+
+var packedTypeCache sync.Map
+
+func inspectPackedValue(layout BufferLayout, value any) (reflect.Value, *packedType, error) {
+	if layout > BufferLayoutScalar {
+		return reflect.Value{}, nil, fmt.Errorf("unsupported buffer layout %s", layout)
+	}
+	target := reflect.ValueOf(value)
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			return reflect.Value{}, nil, fmt.Errorf("cannot pack nil %s", target.Type())
+		}
+		target = target.Elem()
+	}
+	if !target.IsValid() {
+		return reflect.Value{}, nil, fmt.Errorf("cannot pack nil value")
+	}
+	packed, err := newPackedType(layout, target.Type())
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	return target, packed, nil
+}
+
+func newPackedType(layout BufferLayout, typ reflect.Type) (*packedType, error) {
+	key := packedTypeKey{layout: layout, typ: typ}
+	if cached, ok := packedTypeCache.Load(key); ok {
+		return cached.(*packedType), nil
+	}
+	var (
+		packed *packedType
+		err    error
+	)
+	switch {
+	case packedShapes[typ] != nil:
+		packed = newPackedMatrix(layout, typ, packedShapes[typ])
+	case typ.Kind() == reflect.Array:
+		packed, err = newPackedArray(layout, typ)
+	case typ.Kind() == reflect.Struct:
+		packed, err = newPackedStruct(layout, typ)
+	default:
+		packed, err = newPackedScalar(typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	packedTypeCache.Store(key, packed)
+	return packed, nil
+}
+
+func newPackedScalar(typ reflect.Type) (*packedType, error) {
+	var name string
+	switch typ.Kind() {
+	case reflect.Float32:
+		name = "float"
+	case reflect.Float64:
+		name = "double"
+	case reflect.Int32:
+		name = "int"
+	case reflect.Uint32:
+		name = "uint"
+	case reflect.Bool:
+		name = "bool"
+	default:
+		return nil, fmt.Errorf("cannot pack values of type %s", typ)
+	}
+	size := int(typ.Size())
+	if typ.Kind() == reflect.Bool {
+		size = 4
+	}
+	return &packedType{
+		kind:      packedKindScalar,
+		name:      name,
+		size:      size,
+		alignment: size,
+	}, nil
+}
+
+// newPackedMatrix handles both vectors, which are treated as matrices with
+// a single column, and matrices, which are treated as arrays of columns.
+func newPackedMatrix(layout BufferLayout, typ reflect.Type, shape [][]string) *packedType {
+	componentSize := int(typ.Field(0).Type.Size())
+	rows := len(shape[0])
+	columnSize := rows * componentSize
+	columnAlignment := componentSize
+	if layout != BufferLayoutScalar {
+		columnAlignment = rows * componentSize
+		if rows == 3 {
+			columnAlignment = 4 * componentSize
+		}
+	}
+
+	prefix := ""
+	if componentSize == 8 {
+		prefix = "d"
+	}
+	packed := &packedType{
+		kind:      packedKindMatrix,
+		name:      fmt.Sprintf("%svec%d", prefix, rows),
+		size:      columnSize,
+		alignment: columnAlignment,
+
+		componentSize: componentSize,
+		columns:       make([][]int, len(shape)),
+	}
+	for i, names := range shape {
+		packed.columns[i] = make([]int, len(names))
+		for j, name := range names {
+			field, _ := typ.FieldByName(name)
+			packed.columns[i][j] = field.Index[0]
+		}
+	}
+	if len(shape) > 1 {
+		packed.name = fmt.Sprintf("%smat%d", prefix, len(shape))
+		if layout == BufferLayoutStd140 {
+			packed.alignment = roundUp(packed.alignment, 16)
+		}
+		packed.stride = roundUp(columnSize, packed.alignment)
+		packed.size = packed.stride * len(shape)
+	}
+	return packed
+}
+
+func newPackedArray(layout BufferLayout, typ reflect.Type) (*packedType, error) {
+	elem, err := newPackedType(layout, typ.Elem())
+	if err != nil {
+		return nil, err
+	}
+	alignment := elem.alignment
+	if layout == BufferLayoutStd140 {
+		alignment = roundUp(alignment, 16)
+	}
+	stride := roundUp(elem.size, alignment)
+	return &packedType{
+		kind:      packedKindArray,
+		name:      fmt.Sprintf("%s[%d]", elem.name, typ.Len()),
+		size:      stride * typ.Len(),
+		alignment: alignment,
+		stride:    stride,
+		elem:      elem,
+		length:    typ.Len(),
+	}, nil
+}
+
+func newPackedStruct(layout BufferLayout, typ reflect.Type) (*packedType, error) {
+	packed := &packedType{
+		kind:      packedKindStruct,
+		name:      typ.Name(),
+		alignment: 1,
+		fields:    make([]packedField, typ.NumField()),
+	}
+	if packed.name == "" {
+		packed.name = "struct"
+	}
+	offset := 0
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		fieldPacked, err := newPackedType(layout, field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("error packing field %s of %s: %w", field.Name, typ, err)
+		}
+		offset = roundUp(offset, fieldPacked.alignment)
+		packed.fields[i] = packedField{
+			name:   field.Name,
+			offset: offset,
+			packed: fieldPacked,
+		}
+		offset += fieldPacked.size
+		packed.alignment = max(packed.alignment, fieldPacked.alignment)
+	}
+	if layout == BufferLayoutStd140 {
+		packed.alignment = roundUp(packed.alignment, 16)
+	}
+	packed.size = offset
+	if layout != BufferLayoutScalar {
+		packed.size = roundUp(offset, packed.alignment)
+	}
+	return packed, nil
+}
+
+func (t *packedType) write(data []byte, value reflect.Value) {
+	switch t.kind {
+	case packedKindScalar:
+		writePackedScalar(data, value)
+	case packedKindMatrix:
+		for i, column := range t.columns {
+			columnData := data[i*t.stride:]
+			for j, index := range column {
+				writePackedScalar(columnData[j*t.componentSize:], value.Field(index))
+			}
+		}
+	case packedKindArray:
+		for i := range t.length {
+			t.elem.write(data[i*t.stride:], value.Index(i))
+		}
+	case packedKindStruct:
+		for i, field := range t.fields {
+			if value.Type().Field(i).Name == "_" {
+				continue
+			}
+			field.packed.write(data[field.offset:], value.Field(i))
+		}
+	}
+}
+
+func (t *packedType) report(report *BufferLayoutReport, path string, offset int) {
+	switch t.kind {
+	case packedKindArray:
+		for i := range t.length {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			elemOffset := offset + i*t.stride
+			report.Entries = append(report.Entries, t.elem.entry(elemPath, elemOffset))
+			t.elem.report(report, elemPath, elemOffset)
+		}
+	case packedKindStruct:
+		for _, field := range t.fields {
+			fieldPath := field.name
+			if path != "" {
+				fieldPath = path + "." + field.name
+			}
+			fieldOffset := offset + field.offset
+			report.Entries = append(report.Entries, field.packed.entry(fieldPath, fieldOffset))
+			field.packed.report(report, fieldPath, fieldOffset)
+		}
+	}
+}
+
+func (t *packedType) entry(path string, offset int) BufferLayoutEntry {
+	return BufferLayoutEntry{
+		Path:      path,
+		Type:      t.name,
+		Offset:    offset,
+		Size:      t.size,
+		Alignment: t.alignment,
+		Stride:    t.stride,
+	}
+}
+
+func writePackedScalar(data []byte, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Float32:
+		binary.LittleEndian.PutUint32(data, math.Float32bits(float32(value.Float())))
+	case reflect.Float64:
+		binary.LittleEndian.PutUint64(data, math.Float64bits(value.Float()))
+	case reflect.Int32:
+		binary.LittleEndian.PutUint32(data, uint32(value.Int()))
+	case reflect.Uint32:
+		binary.LittleEndian.PutUint32(data, uint32(value.Uint()))
+	case reflect.Bool:
+		if value.Bool() {
+			binary.LittleEndian.PutUint32(data, 1)
+		}
+	}
+}
+
+func roundUp(value, alignment int) int {
+	return (value + alignment - 1) / alignment * alignment
+}
@@ -0,0 +1,175 @@
+package dprec
+
+import (
+	"fmt"
+	"math"
+)
+
+// PlaneSide specifies the position of a point relative to a plane.
+type PlaneSide uint8
+
+const (
+	// PlaneSideOn specifies that the point lies on the plane.
+	PlaneSideOn PlaneSide = iota
+
+	// PlaneSideFront specifies that the point lies on the side of the plane
+	// that the normal is facing.
+	PlaneSideFront
+
+	// PlaneSideBack specifies that the point lies on the side of the plane
+	// that is opposite to the normal.
+	PlaneSideBack
+)
+
+// NewPlane creates a Plane with the given unit normal and signed distance
+// from the origin.
+func NewPlane(normal Vec3, distance float64) Plane {
+	return Plane{
+		Normal:   normal,
+		Distance: distance,
+	}
+}
+
+// PointNormalPlane returns the plane that passes through point and is
+// perpendicular to normal. The normal need not be a unit vector.
+func PointNormalPlane(point, normal Vec3) Plane {
+	unitNormal := UnitVec3(normal)
+	return Plane{
+		Normal:   unitNormal,
+		Distance: Vec3Dot(unitNormal, point),
+	}
+}
+
+// TrianglePlane returns the plane that passes through the points a, b and
+// c. The normal of the plane faces the side from which the points appear
+// in counter-clockwise order.
+//
+// The behavior is undefined if the three points are collinear.
+func TrianglePlane(a, b, c Vec3) Plane {
+	normal := Vec3Cross(Vec3Diff(b, a), Vec3Diff(c, a))
+	return PointNormalPlane(a, normal)
+}
+
+// EquationPlane returns the plane that is described by the equation
+// Ax + By + Cz + D = 0, where the coefficients are passed as the X, Y, Z
+// and W components of the specified vector. The coefficients need not be
+// normalized.
+func EquationPlane(equation Vec4) Plane {
+	normal := equation.VecXYZ()
+	length := normal.Length()
+	return Plane{
+		Normal:   Vec3Quot(normal, length),
+		Distance: -equation.W / length,
+	}
+}
+
+// InversePlane returns the same plane but with the normal facing the
+// opposite direction.
+func InversePlane(plane Plane) Plane {
+	return Plane{
+		Normal:   InverseVec3(plane.Normal),
+		Distance: -plane.Distance,
+	}
+}
+
+// PlanePointProjection returns the point on the plane that is closest to
+// the specified point.
+func PlanePointProjection(plane Plane, point Vec3) Vec3 {
+	distance := plane.SignedDistance(point)
+	return Vec3Diff(point, Vec3Prod(plane.Normal, distance))
+}
+
+// PlanePointReflection returns the mirror image of the specified point
+// with respect to the plane.
+func PlanePointReflection(plane Plane, point Vec3) Vec3 {
+	distance := plane.SignedDistance(point)
+	return Vec3Diff(point, Vec3Prod(plane.Normal, 2.0*distance))
+}
+
+// PlaneDirectionReflection returns the mirror image of the specified
+// direction with respect to the plane. Unlike PlanePointReflection, the
+// position of the plane has no effect on the result.
+func PlaneDirectionReflection(plane Plane, direction Vec3) Vec3 {
+	dot := Vec3Dot(plane.Normal, direction)
+	return Vec3Diff(direction, Vec3Prod(plane.Normal, 2.0*dot))
+}
+
+// Mat4PlaneTransformation applies the 3D transformation to a Plane.
+//
+// The plane equation is transformed by the inverse transpose of the matrix,
+// which keeps the normal perpendicular to the plane even when the matrix
+// contains non-uniform scale.
+func Mat4PlaneTransformation(mat Mat4, plane Plane) Plane {
+	inverseTranspose := TransposedMat4(InverseMat4(mat))
+	return EquationPlane(Mat4Vec4Prod(inverseTranspose, plane.Equation()))
+}
+
+// PlaneIntersectionPoint returns the single point that is shared by the
+// three specified planes. If any two of the planes are parallel, there is
+// no such point and false is returned.
+func PlaneIntersectionPoint(a, b, c Plane) (Vec3, bool) {
+	crossBC := Vec3Cross(b.Normal, c.Normal)
+	denominator := Vec3Dot(a.Normal, crossBC)
+	if Abs(denominator) < Epsilon {
+		return Vec3{}, false
+	}
+	crossCA := Vec3Cross(c.Normal, a.Normal)
+	crossAB := Vec3Cross(a.Normal, b.Normal)
+	return Vec3Quot(Vec3MultiSum(
+		Vec3Prod(crossBC, a.Distance),
+		Vec3Prod(crossCA, b.Distance),
+		Vec3Prod(crossAB, c.Distance),
+	), denominator), true
+}
+
+// Plane represents an infinite plane in 3D space.
+//
+// The plane consists of all points P for which Dot(Normal, P) = Distance,
+// where Normal is a unit vector and Distance is the signed distance of the
+// plane from the origin along Normal.
+type Plane struct {
+	Normal   Vec3
+	Distance float64
+}
+
+// IsNaN returns true if any component is NaN.
+func (p Plane) IsNaN() bool {
+	return p.Normal.IsNaN() || math.IsNaN(p.Distance)
+}
+
+// SignedDistance returns the distance of the point from the plane. The
+// result is positive if the point is on the side the normal is facing and
+// negative otherwise.
+func (p Plane) SignedDistance(point Vec3) float64 {
+	return Vec3Dot(p.Normal, point) - p.Distance
+}
+
+// Classify returns the side of the plane on which the point lies. Points
+// that are within Epsilon of the plane are considered to be on it.
+func (p Plane) Classify(point Vec3) PlaneSide {
+	distance := p.SignedDistance(point)
+	switch {
+	case distance > Epsilon:
+		return PlaneSideFront
+	case distance < -Epsilon:
+		return PlaneSideBack
+	default:
+		return PlaneSideOn
+	}
+}
+
+// Point returns the point on the plane that is closest to the origin.
+func (p Plane) Point() Vec3 {
+	return Vec3Prod(p.Normal, p.Distance)
+}
+
+// Equation returns the plane coefficients A, B, C and D of the equation
+// Ax + By + Cz + D = 0 as the X, Y, Z and W components of a Vec4.
+func (p Plane) Equation() Vec4 {
+	return NewVec4(p.Normal.X, p.Normal.Y, p.Normal.Z, -p.Distance)
+}
+
+// String returns a string representation of the plane.
+func (p Plane) String() string {
+	return fmt.Sprintf("(%s, %f)", p.Normal, p.Distance)
+}
@@ -0,0 +1,130 @@
+package dprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("Plane", func() {
+	var plane Plane
+
+	BeforeEach(func() {
+		plane = NewPlane(NewVec3(0.0, 0.6, 0.8), 2.0)
+	})
+
+	Specify("NewPlane", func() {
+		Expect(plane).To(HavePlaneElements(0.0, 0.6, 0.8, 2.0))
+	})
+
+	Specify("PointNormalPlane", func() {
+		result := PointNormalPlane(NewVec3(1.0, 2.0, 3.0), NewVec3(0.0, 3.0, 4.0))
+		Expect(result).To(HavePlaneElements(0.0, 0.6, 0.8, 3.6))
+	})
+
+	Specify("TrianglePlane", func() {
+		result := TrianglePlane(
+			NewVec3(0.0, 0.0, 2.0),
+			NewVec3(1.0, 0.0, 2.0),
+			NewVec3(0.0, 1.0, 2.0),
+		)
+		Expect(result).To(HavePlaneElements(0.0, 0.0, 1.0, 2.0))
+
+		result = TrianglePlane(
+			NewVec3(0.0, 0.0, 2.0),
+			NewVec3(0.0, 1.0, 2.0),
+			NewVec3(1.0, 0.0, 2.0),
+		)
+		Expect(result).To(HavePlaneElements(0.0, 0.0, -1.0, -2.0))
+	})
+
+	Specify("EquationPlane", func() {
+		result := EquationPlane(NewVec4(0.0, 3.0, 4.0, -10.0))
+		Expect(result).To(HavePlaneElements(0.0, 0.6, 0.8, 2.0))
+	})
+
+	Specify("InversePlane", func() {
+		result := InversePlane(plane)
+		Expect(result).To(HavePlaneElements(0.0, -0.6, -0.8, -2.0))
+	})
+
+	Specify("PlanePointProjection", func() {
+		result := PlanePointProjection(plane, NewVec3(1.0, 3.0, 4.0))
+		Expect(result).To(HaveVec3Coords(1.0, 1.2, 1.6))
+		Expect(plane.SignedDistance(result)).To(EqualFloat64(0.0))
+	})
+
+	Specify("PlanePointReflection", func() {
+		result := PlanePointReflection(plane, NewVec3(1.0, 3.0, 4.0))
+		Expect(result).To(HaveVec3Coords(1.0, -0.6, -0.8))
+	})
+
+	Specify("PlaneDirectionReflection", func() {
+		plane = NewPlane(NewVec3(0.0, 1.0, 0.0), 5.0)
+		result := PlaneDirectionReflection(plane, NewVec3(1.0, -1.0, 0.5))
+		Expect(result).To(HaveVec3Coords(1.0, 1.0, 0.5))
+	})
+
+	Specify("Mat4PlaneTransformation", func() {
+		plane = NewPlane(NewVec3(0.0, 1.0, 0.0), 1.0)
+
+		matrix := TranslationMat4(1.0, 2.0, 3.0)
+		result := Mat4PlaneTransformation(matrix, plane)
+		Expect(result).To(HavePlaneElements(0.0, 1.0, 0.0, 3.0))
+
+		matrix = RotationMat4(Degrees(90.0), 1.0, 0.0, 0.0)
+		result = Mat4PlaneTransformation(matrix, plane)
+		Expect(result).To(HavePlaneElements(0.0, 0.0, 1.0, 1.0))
+
+		plane = PointNormalPlane(ZeroVec3(), NewVec3(1.0, 1.0, 0.0))
+		matrix = ScaleMat4(2.0, 1.0, 1.0)
+		result = Mat4PlaneTransformation(matrix, plane)
+		Expect(result).To(HavePlaneElements(0.4472135954999579, 0.8944271909999159, 0.0, 0.0))
+		Expect(result.SignedDistance(NewVec3(2.0, -1.0, 0.0))).To(EqualFloat64(0.0))
+	})
+
+	Describe("PlaneIntersectionPoint", func() {
+		Specify("intersecting planes", func() {
+			point, ok := PlaneIntersectionPoint(
+				NewPlane(NewVec3(1.0, 0.0, 0.0), 1.0),
+				NewPlane(NewVec3(0.0, 1.0, 0.0), 2.0),
+				NewPlane(NewVec3(0.0, 0.0, -1.0), 3.0),
+			)
+			Expect(ok).To(BeTrue())
+			Expect(point).To(HaveVec3Coords(1.0, 2.0, -3.0))
+		})
+
+		Specify("parallel planes", func() {
+			_, ok := PlaneIntersectionPoint(
+				NewPlane(NewVec3(1.0, 0.0, 0.0), 1.0),
+				NewPlane(NewVec3(0.0, 1.0, 0.0), 2.0),
+				NewPlane(NewVec3(-1.0, 0.0, 0.0), 3.0),
+			)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Specify("#SignedDistance", func() {
+		Expect(plane.SignedDistance(NewVec3(1.0, 3.0, 4.0))).To(EqualFloat64(3.0))
+		Expect(plane.SignedDistance(NewVec3(5.0, 0.6, 0.8))).To(EqualFloat64(-1.0))
+	})
+
+	DescribeTable("#Classify",
+		func(point Vec3, expected PlaneSide) {
+			Expect(plane.Classify(point)).To(Equal(expected))
+		},
+		Entry("front", NewVec3(1.0, 3.0, 4.0), PlaneSideFront),
+		Entry("back", NewVec3(5.0, 0.6, 0.8), PlaneSideBack),
+		Entry("on", NewVec3(7.0, 1.2, 1.6), PlaneSideOn),
+	)
+
+	Specify("#Point", func() {
+		Expect(plane.Point()).To(HaveVec3Coords(0.0, 1.2, 1.6))
+	})
+
+	Specify("#Equation", func() {
+		Expect(plane.Equation()).To(HaveVec4Coords(0.0, 0.6, 0.8, -2.0))
+	})
+})
@@ -0,0 +1,147 @@
+package dprec
+
+import "math"
+
+// DepthRange specifies the range of depth values in normalized device
+// coordinates that a projection matrix maps the near and far planes to.
+type DepthRange uint8
+
+const (
+	// DepthRangeNegativeOneToOne specifies that depth is in the [-1, 1]
+	// range. This is the convention used by OpenGL and by the OrthoMat4 and
+	// PerspectiveMat4 functions.
+	DepthRangeNegativeOneToOne DepthRange = iota
+
+	// DepthRangeZeroToOne specifies that depth is in the [0, 1] range. This
+	// is the convention used by Vulkan, Direct3D and Metal.
+	DepthRangeZeroToOne
+)
+
+// DepthMapping specifies how a projection matrix maps view-space depth to
+// depth in normalized device coordinates.
+//
+// The zero value corresponds to the OpenGL convention that is used by the
+// OrthoMat4 and PerspectiveMat4 functions.
+type DepthMapping struct {
+	// Range specifies the depth range in normalized device coordinates.
+	Range DepthRange
+
+	// Reversed specifies that the near plane is mapped to the upper end of
+	// the depth range and the far plane is mapped to the lower end. When
+	// combined with DepthRangeZeroToOne, this greatly improves the precision
+	// of floating-point depth buffers.
+	Reversed bool
+}
+
+func (m DepthMapping) nearFarDepth() (float64, float64) {
+	near, far := float64(-1.0), float64(1.0)
+	if m.Range == DepthRangeZeroToOne {
+		near = 0.0
+	}
+	if m.Reversed {
+		near, far = far, near
+	}
+	return near, far
+}
+
+// OrthoDepthMat4 returns a 3D orthographic projection matrix that uses the
+// specified depth mapping. The far distance must be finite.
+func OrthoDepthMat4(left, right, top, bottom, near, far float64, mapping DepthMapping) Mat4 {
+	nearDepth, farDepth := mapping.nearFarDepth()
+
+	var result Mat4
+	result.M11 = 2.0 / (right - left)
+	result.M14 = (right + left) / (left - right)
+
+	result.M22 = 2.0 / (top - bottom)
+	result.M24 = (top + bottom) / (bottom - top)
+
+	result.M33 = (nearDepth - farDepth) / (far - near)
+	result.M34 = nearDepth + result.M33*near
+
+	result.M44 = 1.0
+	return result
+}
+
+// InverseOrthoDepthMat4 returns the inverse of the matrix that is returned
+// by OrthoDepthMat4 for the same arguments. It is computed analytically and
+// is more precise than calling InverseMat4 on the projection matrix.
+func InverseOrthoDepthMat4(left, right, top, bottom, near, far float64, mapping DepthMapping) Mat4 {
+	projection := OrthoDepthMat4(left, right, top, bottom, near, far, mapping)
+
+	var result Mat4
+	result.M11 = 1.0 / projection.M11
+	result.M14 = -projection.M14 / projection.M11
+
+	result.M22 = 1.0 / projection.M22
+	result.M24 = -projection.M24 / projection.M22
+
+	result.M33 = 1.0 / projection.M33
+	result.M34 = -projection.M34 / projection.M33
+
+	result.M44 = 1.0
+	return result
+}
+
+// PerspectiveDepthMat4 returns a 3D perspective projection matrix that uses
+// the specified depth mapping. The far distance can be positive infinity,
+// in which case the projection has no far clipping plane.
+func PerspectiveDepthMat4(left, right, bottom, top, near, far float64, mapping DepthMapping) Mat4 {
+	nearDepth, farDepth := mapping.nearFarDepth()
+
+	var result Mat4
+	result.M11 = 2.0 * near / (right - left)
+	result.M13 = (right + left) / (right - left)
+
+	result.M22 = 2.0 * near / (top - bottom)
+	result.M23 = (top + bottom) / (top - bottom)
+
+	if math.IsInf(far, 1) {
+		result.M33 = -farDepth
+	} else {
+		result.M33 = (far*farDepth - near*nearDepth) / (near - far)
+	}
+	result.M34 = near * (nearDepth + result.M33)
+
+	result.M43 = -1.0
+	return result
+}
+
+// InversePerspectiveDepthMat4 returns the inverse of the matrix that is
+// returned by PerspectiveDepthMat4 for the same arguments. It is computed
+// analytically and is more precise than calling InverseMat4 on the
+// projection matrix.
+func InversePerspectiveDepthMat4(left, right, bottom, top, near, far float64, mapping DepthMapping) Mat4 {
+	projection := PerspectiveDepthMat4(left, right, bottom, top, near, far, mapping)
+
+	var result Mat4
+	result.M11 = 1.0 / projection.M11
+	result.M14 = projection.M13 / projection.M11
+
+	result.M22 = 1.0 / projection.M22
+	result.M24 = projection.M23 / projection.M22
+
+	result.M34 = -1.0
+
+	result.M43 = 1.0 / projection.M34
+	result.M44 = projection.M33 / projection.M34
+	return result
+}
+
+// PerspectiveFovDepthMat4 returns a 3D perspective projection matrix for
+// the specified vertical field of view and aspect ratio (width / height)
+// that uses the specified depth mapping. The far distance can be positive
+// infinity, in which case the projection has no far clipping plane.
+func PerspectiveFovDepthMat4(fovY Angle, aspect, near, far float64, mapping DepthMapping) Mat4 {
+	top := near * Tan(fovY/2.0)
+	right := top * aspect
+	return PerspectiveDepthMat4(-right, right, -top, top, near, far, mapping)
+}
+
+// InversePerspectiveFovDepthMat4 returns the inverse of the matrix that is
+// returned by PerspectiveFovDepthMat4 for the same arguments.
+func InversePerspectiveFovDepthMat4(fovY Angle, aspect, near, far float64, mapping DepthMapping) Mat4 {
+	top := near * Tan(fovY/2.0)
+	right := top * aspect
+	return InversePerspectiveDepthMat4(-right, right, -top, top, near, far, mapping)
+}
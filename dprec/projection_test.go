@@ -0,0 +1,134 @@
+package dprec_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("Projection", func() {
+	projectedDepth := func(projection Mat4, distance float64) float64 {
+		clip := Mat4Vec4Prod(projection, NewVec4(0.0, 0.0, -distance, 1.0))
+		return clip.Z / clip.W
+	}
+
+	expectIdentity := func(matrix Mat4) {
+		Expect(matrix).To(HaveMat4Elements(
+			1.0, 0.0, 0.0, 0.0,
+			0.0, 1.0, 0.0, 0.0,
+			0.0, 0.0, 1.0, 0.0,
+			0.0, 0.0, 0.0, 1.0,
+		))
+	}
+
+	DescribeTable("OrthoDepthMat4",
+		func(mapping DepthMapping, expectedNear, expectedFar float64) {
+			projection := OrthoDepthMat4(-2.0, 2.0, 1.0, -1.0, 1.0, 3.0, mapping)
+			Expect(projectedDepth(projection, 1.0)).To(EqualFloat64(expectedNear))
+			Expect(projectedDepth(projection, 3.0)).To(EqualFloat64(expectedFar))
+
+			corner := Mat4Vec4Prod(projection, NewVec4(2.0, 1.0, -2.0, 1.0))
+			Expect(corner.X).To(EqualFloat64(1.0))
+			Expect(corner.Y).To(EqualFloat64(1.0))
+		},
+		Entry("negative one to one", DepthMapping{}, float64(-1.0), float64(1.0)),
+		Entry("zero to one", DepthMapping{Range: DepthRangeZeroToOne}, float64(0.0), float64(1.0)),
+		Entry("reversed negative one to one", DepthMapping{Reversed: true}, float64(1.0), float64(-1.0)),
+		Entry("reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}, float64(1.0), float64(0.0)),
+	)
+
+	Specify("OrthoDepthMat4 matches OrthoMat4", func() {
+		expected := OrthoMat4(-1.1, 2.1, 1.5, -3.4, 1.7, 3.8)
+		projection := OrthoDepthMat4(-1.1, 2.1, 1.5, -3.4, 1.7, 3.8, DepthMapping{})
+		Expect(projection).To(HaveMat4Elements(
+			expected.M11, expected.M12, expected.M13, expected.M14,
+			expected.M21, expected.M22, expected.M23, expected.M24,
+			expected.M31, expected.M32, expected.M33, expected.M34,
+			expected.M41, expected.M42, expected.M43, expected.M44,
+		))
+	})
+
+	DescribeTable("InverseOrthoDepthMat4",
+		func(mapping DepthMapping) {
+			projection := OrthoDepthMat4(-1.1, 2.1, 1.5, -3.4, 1.7, 3.8, mapping)
+			inverse := InverseOrthoDepthMat4(-1.1, 2.1, 1.5, -3.4, 1.7, 3.8, mapping)
+			expectIdentity(Mat4Prod(inverse, projection))
+		},
+		Entry("negative one to one", DepthMapping{}),
+		Entry("zero to one", DepthMapping{Range: DepthRangeZeroToOne}),
+		Entry("reversed negative one to one", DepthMapping{Reversed: true}),
+		Entry("reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}),
+	)
+
+	DescribeTable("PerspectiveDepthMat4",
+		func(mapping DepthMapping, far, expectedNear, expectedFar float64) {
+			projection := PerspectiveDepthMat4(-1.0, 1.0, -0.5, 0.5, 1.0, far, mapping)
+			Expect(projectedDepth(projection, 1.0)).To(EqualFloat64(expectedNear))
+			if math.IsInf(far, 1) {
+				Expect(projectedDepth(projection, 1000000.0)).To(BeNumerically("~", expectedFar, 0.00001))
+			} else {
+				Expect(projectedDepth(projection, far)).To(EqualFloat64(expectedFar))
+			}
+
+			corner := Mat4Vec4Prod(projection, NewVec4(2.0, 1.0, -2.0, 1.0))
+			Expect(corner.X / corner.W).To(EqualFloat64(1.0))
+			Expect(corner.Y / corner.W).To(EqualFloat64(1.0))
+		},
+		Entry("negative one to one", DepthMapping{}, float64(3.0), float64(-1.0), float64(1.0)),
+		Entry("zero to one", DepthMapping{Range: DepthRangeZeroToOne}, float64(3.0), float64(0.0), float64(1.0)),
+		Entry("reversed negative one to one", DepthMapping{Reversed: true}, float64(3.0), float64(1.0), float64(-1.0)),
+		Entry("reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}, float64(3.0), float64(1.0), float64(0.0)),
+		Entry("infinite negative one to one", DepthMapping{}, math.Inf(1), float64(-1.0), float64(1.0)),
+		Entry("infinite zero to one", DepthMapping{Range: DepthRangeZeroToOne}, math.Inf(1), float64(0.0), float64(1.0)),
+		Entry("infinite reversed negative one to one", DepthMapping{Reversed: true}, math.Inf(1), float64(1.0), float64(-1.0)),
+		Entry("infinite reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}, math.Inf(1), float64(1.0), float64(0.0)),
+	)
+
+	Specify("PerspectiveDepthMat4 matches PerspectiveMat4", func() {
+		expected := PerspectiveMat4(-1.1, 2.1, -3.4, 1.5, 1.7, 3.8)
+		projection := PerspectiveDepthMat4(-1.1, 2.1, -3.4, 1.5, 1.7, 3.8, DepthMapping{})
+		Expect(projection).To(HaveMat4Elements(
+			expected.M11, expected.M12, expected.M13, expected.M14,
+			expected.M21, expected.M22, expected.M23, expected.M24,
+			expected.M31, expected.M32, expected.M33, expected.M34,
+			expected.M41, expected.M42, expected.M43, expected.M44,
+		))
+	})
+
+	DescribeTable("InversePerspectiveDepthMat4",
+		func(mapping DepthMapping, far float64) {
+			projection := PerspectiveDepthMat4(-1.1, 2.1, -3.4, 1.5, 1.7, far, mapping)
+			inverse := InversePerspectiveDepthMat4(-1.1, 2.1, -3.4, 1.5, 1.7, far, mapping)
+			expectIdentity(Mat4Prod(inverse, projection))
+		},
+		Entry("negative one to one", DepthMapping{}, float64(3.8)),
+		Entry("zero to one", DepthMapping{Range: DepthRangeZeroToOne}, float64(3.8)),
+		Entry("reversed negative one to one", DepthMapping{Reversed: true}, float64(3.8)),
+		Entry("reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}, float64(3.8)),
+		Entry("infinite negative one to one", DepthMapping{}, math.Inf(1)),
+		Entry("infinite reversed zero to one", DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}, math.Inf(1)),
+	)
+
+	Specify("PerspectiveFovDepthMat4", func() {
+		mapping := DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+		expected := PerspectiveDepthMat4(-3.0, 3.0, -1.5, 1.5, 1.5, 3.0, mapping)
+		projection := PerspectiveFovDepthMat4(Degrees(90.0), 2.0, 1.5, 3.0, mapping)
+		Expect(projection).To(HaveMat4Elements(
+			expected.M11, expected.M12, expected.M13, expected.M14,
+			expected.M21, expected.M22, expected.M23, expected.M24,
+			expected.M31, expected.M32, expected.M33, expected.M34,
+			expected.M41, expected.M42, expected.M43, expected.M44,
+		))
+	})
+
+	Specify("InversePerspectiveFovDepthMat4", func() {
+		mapping := DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+		projection := PerspectiveFovDepthMat4(Degrees(60.0), 1.5, 0.5, 3.0, mapping)
+		inverse := InversePerspectiveFovDepthMat4(Degrees(60.0), 1.5, 0.5, 3.0, mapping)
+		expectIdentity(Mat4Prod(inverse, projection))
+	})
+})
@@ -1,37 +1,34 @@
 package dprec
 
-import (
-	"fmt"
-	"math"
-)
+import "github.com/mokiat/gomath/gen"
 
 // RotationOrder specifies the order in which rotations are applied.
-type RotationOrder uint8
+type RotationOrder = gen.RotationOrder
 
 const (
 	// RotationOrderGlobalXYZ specifies that rotations are applied in the order
 	// of X, Y, Z using a global gizmo.
-	RotationOrderGlobalXYZ RotationOrder = iota
+	RotationOrderGlobalXYZ = gen.RotationOrderGlobalXYZ
 
 	// RotationOrderGlobalXZY specifies that rotations are applied in the order
 	// of X, Z, Y using a global gizmo.
-	RotationOrderGlobalXZY
+	RotationOrderGlobalXZY = gen.RotationOrderGlobalXZY
 
 	// RotationOrderGlobalYXZ specifies that rotations are applied in the order
 	// of Y, X, Z using a global gizmo.
-	RotationOrderGlobalYXZ
+	RotationOrderGlobalYXZ = gen.RotationOrderGlobalYXZ
 
 	// RotationOrderGlobalYZX specifies that rotations are applied in the order
 	// of Y, Z, X using a global gizmo.
-	RotationOrderGlobalYZX
+	RotationOrderGlobalYZX = gen.RotationOrderGlobalYZX
 
 	// RotationOrderGlobalZXY specifies that rotations are applied in the order
 	// of Z, X, Y using a global gizmo.
-	RotationOrderGlobalZXY
+	RotationOrderGlobalZXY = gen.RotationOrderGlobalZXY
 
 	// RotationOrderGlobalZYX specifies that rotations are applied in the order
 	// of Z, Y, X using a global gizmo.
-	RotationOrderGlobalZYX
+	RotationOrderGlobalZYX = gen.RotationOrderGlobalZYX
 )
 
 const (
@@ -62,249 +59,275 @@ const (
 
 // NewQuat creates a quaternion with the given W, X, Y, and Z components.
 func NewQuat(w, x, y, z float64) Quat {
-	return Quat{
-		W: w,
-		X: x,
-		Y: y,
-		Z: z,
-	}
+	return Quat(gen.NewQuat(w, x, y, z))
 }
 
 // IdentityQuat returns the identity quaternion (no rotation).
 func IdentityQuat() Quat {
-	return Quat{
-		W: 1.0,
-		X: 0.0,
-		Y: 0.0,
-		Z: 0.0,
-	}
+	return Quat(gen.IdentityQuat[float64]())
 }
 
 // NegativeQuat returns the negation of the given quaternion.
 func NegativeQuat(q Quat) Quat {
-	return Quat{
-		W: -q.W,
-		X: -q.X,
-		Y: -q.Y,
-		Z: -q.Z,
-	}
+	return Quat(gen.NegativeQuat(gen.Quat[float64](q)))
 }
 
 // RotationQuat returns a quaternion representing a rotation of angle around
 // the given axis direction.
 func RotationQuat(angle Angle, direction Vec3) Quat {
-	cs := Cos(angle / 2.0)
-	sn := Sin(angle / 2.0)
-	normalizedDirection := UnitVec3(direction)
-	return Quat{
-		W: cs,
-		X: sn * normalizedDirection.X,
-		Y: sn * normalizedDirection.Y,
-		Z: sn * normalizedDirection.Z,
-	}
+	return Quat(gen.RotationQuat(angle.Radians(), gen.Vec3[float64](direction)))
 }
 
 // EulerQuat returns a quaternion from the given Euler angles and rotation
 // order.
 func EulerQuat(x, y, z Angle, order RotationOrder) Quat {
-	xRot := RotationQuat(x, BasisXVec3())
-	yRot := RotationQuat(y, BasisYVec3())
-	zRot := RotationQuat(z, BasisZVec3())
-	switch order {
-	case RotationOrderGlobalXYZ:
-		return QuatProd(QuatProd(zRot, yRot), xRot)
-	case RotationOrderGlobalXZY:
-		return QuatProd(QuatProd(yRot, zRot), xRot)
-	case RotationOrderGlobalYXZ:
-		return QuatProd(QuatProd(zRot, xRot), yRot)
-	case RotationOrderGlobalYZX:
-		return QuatProd(QuatProd(xRot, zRot), yRot)
-	case RotationOrderGlobalZXY:
-		return QuatProd(QuatProd(yRot, xRot), zRot)
-	case RotationOrderGlobalZYX:
-		return QuatProd(QuatProd(xRot, yRot), zRot)
-	default:
-		return IdentityQuat()
-	}
+	return Quat(gen.EulerQuat(x.Radians(), y.Radians(), z.Radians(), order))
+}
+
+// ShortestArcQuat returns the quaternion that rotates the from direction
+// onto the to direction along the shortest arc. The two vectors need not
+// be normalized. If they point in nearly opposite directions, the rotation
+// is by 180 degrees around an axis perpendicular to from, as returned by
+// NormalVec3.
+func ShortestArcQuat(from, to Vec3) Quat {
+	return Quat(gen.ShortestArcQuat(gen.Vec3[float64](from), gen.Vec3[float64](to)))
+}
+
+// LookRotationQuat returns the orientation of an object that looks along
+// the forward direction. The up vector specifies the approximate upward
+// direction of the object. If it is parallel to forward, a perpendicular
+// direction returned by NormalVec3 is used instead.
+//
+// This matches the convention of LookAtTransformationMat4, which means that
+// the object looks along its negative Z axis, with X pointing to the right
+// and Y pointing up.
+func LookRotationQuat(forward, up Vec3) Quat {
+	return Quat(gen.LookRotationQuat(gen.Vec3[float64](forward), gen.Vec3[float64](up)))
 }
 
 // ConjugateQuat returns the conjugate of the given quaternion.
 func ConjugateQuat(q Quat) Quat {
-	return Quat{
-		W: q.W,
-		X: -q.X,
-		Y: -q.Y,
-		Z: -q.Z,
-	}
+	return Quat(gen.ConjugateQuat(gen.Quat[float64](q)))
 }
 
 // QuatScalarProd multiplies a quaternion by a scalar value.
 func QuatScalarProd(q Quat, value float64) Quat {
-	return Quat{
-		W: q.W * value,
-		X: q.X * value,
-		Y: q.Y * value,
-		Z: q.Z * value,
-	}
+	return Quat(gen.QuatScalarProd(gen.Quat[float64](q), value))
 }
 
 // QuatScalarQuot divides a quaternion by a scalar value.
 func QuatScalarQuot(q Quat, value float64) Quat {
-	invValue := 1.0 / value
-	return Quat{
-		W: q.W * invValue,
-		X: q.X * invValue,
-		Y: q.Y * invValue,
-		Z: q.Z * invValue,
-	}
+	return Quat(gen.QuatScalarQuot(gen.Quat[float64](q), value))
 }
 
 // QuatProd returns the product of two quaternions, applying second's rotation
 // after first's.
 func QuatProd(first, second Quat) Quat {
-	return Quat{
-		W: first.W*second.W - first.X*second.X - first.Y*second.Y - first.Z*second.Z,
-		X: first.W*second.X + first.X*second.W + first.Y*second.Z - first.Z*second.Y,
-		Y: first.W*second.Y - first.X*second.Z + first.Y*second.W + first.Z*second.X,
-		Z: first.W*second.Z + first.X*second.Y - first.Y*second.X + first.Z*second.W,
-	}
+	return Quat(gen.QuatProd(gen.Quat[float64](first), gen.Quat[float64](second)))
 }
 
 // QuatDot returns the dot product of two quaternions.
 func QuatDot(a, b Quat) float64 {
-	return a.W*b.W + a.X*b.X + a.Y*b.Y + a.Z*b.Z
+	return gen.QuatDot(gen.Quat[float64](a), gen.Quat[float64](b))
 }
 
 // QuatLerp returns the linear interpolation between first and second using t.
 // A value of t=0 returns first and t=1 returns second.
 func QuatLerp(first, second Quat, t float64) Quat {
-	return Quat{
-		W: first.W + t*(second.W-first.W),
-		X: first.X + t*(second.X-first.X),
-		Y: first.Y + t*(second.Y-first.Y),
-		Z: first.Z + t*(second.Z-first.Z),
-	}
+	return Quat(gen.QuatLerp(gen.Quat[float64](first), gen.Quat[float64](second), t))
 }
 
 // QuatNLerp returns the normalized linear interpolation between first and
 // second using t.
 func QuatNLerp(first, second Quat, t float64) Quat {
-	return UnitQuat(QuatLerp(first, second, t))
+	return Quat(gen.QuatNLerp(gen.Quat[float64](first), gen.Quat[float64](second), t))
 }
 
 // QuatDiff returns the rotation from first to second. When shortest is true,
 // the result uses the shorter arc between the two orientations.
 func QuatDiff(second, first Quat, shortest bool) Quat {
-	if shortest && (QuatDot(second, first) < 0) {
-		second = NegativeQuat(second)
-	}
-	return QuatProd(second, ConjugateQuat(first))
+	return Quat(gen.QuatDiff(gen.Quat[float64](second), gen.Quat[float64](first), shortest))
 }
 
 // QuatPow raises the quaternion to the given power.
 func QuatPow(q Quat, pow float64) Quat {
-	if q.W > 1.0 {
-		return IdentityQuat()
-	}
-	if q.W < -1.0 {
-		return NegativeQuat(IdentityQuat())
-	}
-	norm := NewVec3(q.X, q.Y, q.Z)
-	if norm.IsZero() {
-		return IdentityQuat()
-	}
-	angle := (2 * Acos(q.W)) * Angle(pow)
-	return RotationQuat(angle, norm)
+	return Quat(gen.QuatPow(gen.Quat[float64](q), pow))
+}
+
+// QuatExp returns the exponential of the quaternion.
+func QuatExp(q Quat) Quat {
+	return Quat(gen.QuatExp(gen.Quat[float64](q)))
+}
+
+// QuatLog returns the natural logarithm of the quaternion. For a unit
+// quaternion that represents a rotation of angle around some axis, the
+// result is the pure quaternion with vector part axis * angle / 2.
+func QuatLog(q Quat) Quat {
+	return Quat(gen.QuatLog(gen.Quat[float64](q)))
+}
+
+// AngularVelocityVec3 returns the constant angular velocity, in radians
+// per unit of time, that rotates the first orientation into the second one
+// over the duration dt, taking the shortest path. The velocity is in world
+// space, which means that its direction is the axis of rotation and its
+// length is the angular speed.
+func AngularVelocityVec3(first, second Quat, dt float64) Vec3 {
+	return Vec3(gen.AngularVelocityVec3(gen.Quat[float64](first), gen.Quat[float64](second), dt))
+}
+
+// AngularVelocityQuat returns the rotation that results from applying the
+// specified angular velocity for the duration dt. This is the inverse of
+// AngularVelocityVec3, such that QuatProd(AngularVelocityQuat(velocity, dt),
+// first) is the second orientation.
+func AngularVelocityQuat(velocity Vec3, dt float64) Quat {
+	return Quat(gen.AngularVelocityQuat(gen.Vec3[float64](velocity), dt))
+}
+
+// QuatFirstOrderIntegration returns the orientation q after it has been
+// rotated by the world space angular velocity for the duration dt, using
+// first-order (explicit Euler) integration. The result is normalized.
+//
+// This is the cheapest option and is accurate for small rotations per step.
+// For an exact result use AngularVelocityQuat instead.
+func QuatFirstOrderIntegration(q Quat, velocity Vec3, dt float64) Quat {
+	return Quat(gen.QuatFirstOrderIntegration(gen.Quat[float64](q), gen.Vec3[float64](velocity), dt))
+}
+
+// QuatSecondOrderIntegration is like QuatFirstOrderIntegration but uses a
+// second-order Taylor expansion, which reduces the error for larger
+// rotations per step at a small extra cost.
+func QuatSecondOrderIntegration(q Quat, velocity Vec3, dt float64) Quat {
+	return Quat(gen.QuatSecondOrderIntegration(gen.Quat[float64](q), gen.Vec3[float64](velocity), dt))
 }
 
 // QuatSlerp returns the spherical linear interpolation between first and
 // second using t.
 func QuatSlerp(first, second Quat, t float64) Quat {
-	delta := QuatDiff(second, first, true)
-	fractDelta := QuatPow(delta, t)
-	return QuatProd(fractDelta, first)
+	return Quat(gen.QuatSlerp(gen.Quat[float64](first), gen.Quat[float64](second), t))
+}
+
+// QuatSquad returns the spherical quadrangle interpolation (SQUAD) between
+// first and second using t, where firstControl and secondControl are the
+// intermediate control points of first and second, as returned by
+// QuatSquadControl. A value of t=0 returns first and t=1 returns second.
+func QuatSquad(first, firstControl, secondControl, second Quat, t float64) Quat {
+	return Quat(gen.QuatSquad(gen.Quat[float64](first), gen.Quat[float64](firstControl), gen.Quat[float64](secondControl), gen.Quat[float64](second), t))
+}
+
+// QuatSquadControl returns the intermediate SQUAD control point of current,
+// such that the interpolation through prev, current and next is smooth at
+// current. The quaternions need to be normalized.
+func QuatSquadControl(prev, current, next Quat) Quat {
+	return Quat(gen.QuatSquadControl(gen.Quat[float64](prev), gen.Quat[float64](current), gen.Quat[float64](next)))
 }
 
 // QuatVec3Rotation rotates the vector v by the quaternion q.
 func QuatVec3Rotation(q Quat, v Vec3) Vec3 {
-	// Uses the direct formula: v + 2.0 * cross(q.xyz, cross(q.xyz, v) + q.w * v)
-	// See https://en.wikipedia.org/wiki/Quaternions_and_spatial_rotation#Used_methods
-	qAxis := NewVec3(q.X, q.Y, q.Z)
-	shared := Vec3Prod(Vec3Cross(qAxis, v), 2.0)
-	return Vec3Sum(
-		Vec3Sum(v, Vec3Prod(shared, q.W)),
-		Vec3Cross(qAxis, shared),
-	)
+	return Vec3(gen.QuatVec3Rotation(gen.Quat[float64](q), gen.Vec3[float64](v)))
 }
 
 // UnitQuat returns the normalized quaternion.
 func UnitQuat(q Quat) Quat {
-	return QuatScalarQuot(q, q.Norm())
+	return Quat(gen.UnitQuat(gen.Quat[float64](q)))
 }
 
 // InverseQuat returns the multiplicative inverse of the given quaternion.
 func InverseQuat(q Quat) Quat {
-	return QuatScalarQuot(ConjugateQuat(q), q.SqrNorm())
+	return Quat(gen.InverseQuat(gen.Quat[float64](q)))
 }
 
-// Quat is a quaternion with float64 components.
-type Quat struct {
-	W float64
-	X float64
-	Y float64
-	Z float64
+// ClampTwistQuat limits the twist of the rotation around axis, as returned
+// by Quat.SwingTwist, to the range [minAngle, maxAngle]. The swing is
+// preserved. The angles are signed, following the right-hand rule around
+// axis, and should be within [-180, 180] degrees.
+func ClampTwistQuat(q Quat, axis Vec3, minAngle, maxAngle Angle) Quat {
+	return Quat(gen.ClampTwistQuat(gen.Quat[float64](q), gen.Vec3[float64](axis), minAngle.Radians(), maxAngle.Radians()))
+}
+
+// ClampSwingQuat limits the swing of the rotation away from axis, as
+// returned by Quat.SwingTwist, to a cone with the specified maximum angle.
+// The twist around axis is preserved.
+func ClampSwingQuat(q Quat, axis Vec3, maxAngle Angle) Quat {
+	return Quat(gen.ClampSwingQuat(gen.Quat[float64](q), gen.Vec3[float64](axis), maxAngle.Radians()))
+}
+
+// ClampSwingEllipseQuat limits the swing of the rotation away from axis, as
+// returned by Quat.SwingTwist, to an elliptical cone. The swing towards the
+// reference direction (and away from it) is limited to maxAngleA and the
+// swing towards Vec3Cross(axis, reference) is limited to maxAngleB. The
+// reference direction needs to not be parallel to axis.
+//
+// Swings outside of the cone are scaled down towards the axis, keeping
+// their direction, until they reach its boundary. The twist around axis is
+// preserved.
+func ClampSwingEllipseQuat(q Quat, axis, reference Vec3, maxAngleA, maxAngleB Angle) Quat {
+	return Quat(gen.ClampSwingEllipseQuat(gen.Quat[float64](q), gen.Vec3[float64](axis), gen.Vec3[float64](reference), maxAngleA.Radians(), maxAngleB.Radians()))
 }
 
+// Quat is a quaternion with float64 components.
+type Quat gen.Quat[float64]
+
 // IsNaN returns true if any component is NaN.
 func (q Quat) IsNaN() bool {
-	return math.IsNaN(q.X) || math.IsNaN(q.Y) || math.IsNaN(q.Z) || math.IsNaN(q.W)
+	return gen.Quat[float64](q).IsNaN()
 }
 
 // IsInf returns true if any component is Inf.
 func (q Quat) IsInf() bool {
-	return math.IsInf(q.X, 0) || math.IsInf(q.Y, 0) || math.IsInf(q.Z, 0) || math.IsInf(q.W, 0)
+	return gen.Quat[float64](q).IsInf()
 }
 
 // IsIdentity returns true if this is the identity quaternion.
 func (q Quat) IsIdentity() bool {
-	return Eq(q.X, 0.0) && Eq(q.Y, 0.0) && Eq(q.Z, 0.0) && Eq(q.W, 1.0)
+	return gen.Quat[float64](q).IsIdentity()
 }
 
 // SqrNorm returns the squared norm of the quaternion.
 func (q Quat) SqrNorm() float64 {
-	return q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z
+	return gen.Quat[float64](q).SqrNorm()
 }
 
 // Norm returns the norm (magnitude) of the quaternion.
 func (q Quat) Norm() float64 {
-	return Sqrt(q.SqrNorm())
+	return gen.Quat[float64](q).Norm()
 }
 
 // OrientationX returns the X basis vector of the rotation.
 func (q Quat) OrientationX() Vec3 {
-	return Vec3{
-		X: 1.0 - 2.0*(q.Y*q.Y+q.Z*q.Z),
-		Y: 2.0 * (q.X*q.Y + q.W*q.Z),
-		Z: 2.0 * (q.X*q.Z - q.W*q.Y),
-	}
+	return Vec3(gen.Quat[float64](q).OrientationX())
 }
 
 // OrientationY returns the Y basis vector of the rotation.
 func (q Quat) OrientationY() Vec3 {
-	return Vec3{
-		X: 2.0 * (q.X*q.Y - q.W*q.Z),
-		Y: 1.0 - 2.0*(q.X*q.X+q.Z*q.Z),
-		Z: 2.0 * (q.Y*q.Z + q.W*q.X),
-	}
+	return Vec3(gen.Quat[float64](q).OrientationY())
 }
 
 // OrientationZ returns the Z basis vector of the rotation.
 func (q Quat) OrientationZ() Vec3 {
-	return Vec3{
-		X: 2.0 * (q.X*q.Z + q.W*q.Y),
-		Y: 2.0 * (q.Y*q.Z - q.W*q.X),
-		Z: 1.0 - 2.0*(q.X*q.X+q.Y*q.Y),
-	}
+	return Vec3(gen.Quat[float64](q).OrientationZ())
+}
+
+// AxisAngle returns the rotation axis and the angle of the quaternion,
+// which is the inverse of RotationQuat. The angle is in the range
+// [0, 360] degrees and the axis is a unit vector. If there is no rotation,
+// the X basis vector is returned as the axis.
+func (q Quat) AxisAngle() (Vec3, Angle) {
+	axis, angle := gen.Quat[float64](q).AxisAngle()
+	return Vec3(axis), Radians(angle)
+}
+
+// SwingTwist decomposes the rotation into a twist around the specified axis
+// and a swing around an axis that is perpendicular to it, such that the
+// rotation is equal to QuatProd(swing, twist). That is, the twist is applied
+// first, followed by the swing.
+//
+// If the rotation is a half turn around an axis perpendicular to axis, the
+// twist is ambiguous and the identity quaternion is returned for it.
+//
+// NOTE: This assumes that the quaternion is normalized.
+func (q Quat) SwingTwist(axis Vec3) (swing, twist Quat) {
+	genSwing, genTwist := gen.Quat[float64](q).SwingTwist(gen.Vec3[float64](axis))
+	return Quat(genSwing), Quat(genTwist)
 }
 
 // EulerAngles returns the Euler rotation angles for the given quaternion
@@ -314,72 +337,11 @@ func (q Quat) OrientationZ() Vec3 {
 //
 // NOTE: This assumes that the quaternion is normalized.
 func (q Quat) EulerAngles(order RotationOrder) (x Angle, y Angle, z Angle) {
-	switch order {
-	case RotationOrderGlobalXYZ:
-		x = Atan2(
-			2.0*(q.W*q.X+q.Y*q.Z),
-			1.0-2.0*(q.X*q.X+q.Y*q.Y),
-		)
-		y = Asin(2.0 * (q.W*q.Y - q.X*q.Z))
-		z = Atan2(
-			2.0*(q.W*q.Z+q.X*q.Y),
-			1.0-2.0*(q.Y*q.Y+q.Z*q.Z),
-		)
-	case RotationOrderGlobalXZY:
-		x = Atan2(
-			2.0*(q.W*q.X-q.Z*q.Y),
-			1.0-2.0*(q.X*q.X+q.Z*q.Z),
-		)
-		y = Atan2(
-			2.0*(q.W*q.Y-q.X*q.Z),
-			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
-		)
-		z = Asin(2.0 * (q.W*q.Z + q.X*q.Y))
-	case RotationOrderGlobalYXZ:
-		x = Asin(2.0 * (q.W*q.X + q.Z*q.Y))
-		y = Atan2(
-			2.0*(q.W*q.Y-q.Z*q.X),
-			1.0-2.0*(q.X*q.X+q.Y*q.Y),
-		)
-		z = Atan2(
-			2.0*(q.W*q.Z-q.X*q.Y),
-			1.0-2.0*(q.Z*q.Z+q.X*q.X),
-		)
-	case RotationOrderGlobalYZX:
-		x = Atan2(
-			2.0*(q.W*q.X+q.Z*q.Y),
-			1.0-2.0*(q.X*q.X+q.Z*q.Z),
-		)
-		y = Atan2(
-			2.0*(q.W*q.Y+q.X*q.Z),
-			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
-		)
-		z = Asin(2.0 * (q.W*q.Z - q.X*q.Y))
-	case RotationOrderGlobalZXY:
-		x = Asin(2.0 * (q.W*q.X - q.Z*q.Y))
-		y = Atan2(
-			2.0*(q.W*q.Y+q.Z*q.X),
-			1.0-2.0*(q.X*q.X+q.Y*q.Y),
-		)
-		z = Atan2(
-			2.0*(q.W*q.Z+q.X*q.Y),
-			1.0-2.0*(q.Z*q.Z+q.X*q.X),
-		)
-	case RotationOrderGlobalZYX:
-		x = Atan2(
-			2.0*(q.W*q.X-q.Z*q.Y),
-			1.0-2.0*(q.Y*q.Y+q.X*q.X),
-		)
-		y = Asin(2.0 * (q.W*q.Y + q.Z*q.X))
-		z = Atan2(
-			2.0*(q.W*q.Z-q.Y*q.X),
-			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
-		)
-	}
-	return
+	radX, radY, radZ := gen.Quat[float64](q).EulerAngles(order)
+	return Radians(radX), Radians(radY), Radians(radZ)
 }
 
 // String returns a string representation of the quaternion.
 func (q Quat) String() string {
-	return fmt.Sprintf("(%f, %f, %f, %f)", q.W, q.X, q.Y, q.Z)
+	return gen.Quat[float64](q).String()
 }
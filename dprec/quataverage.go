@@ -0,0 +1,124 @@
+package dprec
+
+import "fmt"
+
+// QuatAverage returns the weighted average of the specified unit
+// quaternions. The result is the eigenvector that corresponds to the
+// largest eigenvalue of the weighted sum of the quaternion outer products,
+// which makes it independent of the order and the signs of the
+// quaternions. The sign of the result is chosen to be on the hemisphere of
+// the first quaternion.
+//
+// The identity quaternion is returned if there are no quaternions. The
+// function panics if the number of weights does not match the number of
+// quaternions.
+func QuatAverage(quats []Quat, weights []float64) Quat {
+	checkQuatWeights("QuatAverage", quats, weights)
+	if len(quats) == 0 {
+		return IdentityQuat()
+	}
+	var m [4][4]float64
+	for i, quat := range quats {
+		components := [4]float64{quat.W, quat.X, quat.Y, quat.Z}
+		for row := range 4 {
+			for column := range 4 {
+				m[row][column] += weights[i] * components[row] * components[column]
+			}
+		}
+	}
+	vector := largestEigenvector4(m)
+	result := UnitQuat(NewQuat(vector[0], vector[1], vector[2], vector[3]))
+	if QuatDot(result, quats[0]) < 0.0 {
+		result = NegativeQuat(result)
+	}
+	return result
+}
+
+// QuatFastAverage is a faster alternative to QuatAverage that returns the
+// normalized weighted sum of the quaternions, where quaternions on the
+// opposite hemisphere to the first one are negated. The result is close to
+// the one of QuatAverage when the quaternions are close to each other.
+//
+// The empty input and weight requirements are the same as for
+// QuatAverage.
+func QuatFastAverage(quats []Quat, weights []float64) Quat {
+	checkQuatWeights("QuatFastAverage", quats, weights)
+	if len(quats) == 0 {
+		return IdentityQuat()
+	}
+	var result Quat
+	for i, quat := range quats {
+		weight := weights[i]
+		if QuatDot(quats[0], quat) < 0.0 {
+			weight = -weight
+		}
+		result = quatSum(result, QuatScalarProd(quat, weight))
+	}
+	return UnitQuat(result)
+}
+
+func checkQuatWeights(function string, quats []Quat, weights []float64) {
+	if len(weights) != len(quats) {
+		panic(fmt.Sprintf("%s: got %d weights for %d quaternions", function, len(weights), len(quats)))
+	}
+}
+
+// largestEigenvector4 returns the eigenvector of the symmetric matrix m
+// that corresponds to its largest eigenvalue. It uses the cyclic Jacobi
+// eigenvalue algorithm.
+func largestEigenvector4(m [4][4]float64) [4]float64 {
+	const maxSweeps = 32
+
+	var v [4][4]float64
+	for i := range 4 {
+		v[i][i] = 1.0
+	}
+	for range maxSweeps {
+		var diagonal, offDiagonal float64
+		for p := range 4 {
+			diagonal += m[p][p] * m[p][p]
+			for q := p + 1; q < 4; q++ {
+				offDiagonal += m[p][q] * m[p][q]
+			}
+		}
+		if offDiagonal <= Epsilon*Epsilon*diagonal {
+			break
+		}
+		for p := range 4 {
+			for q := p + 1; q < 4; q++ {
+				if m[p][q] == 0.0 {
+					continue
+				}
+				theta := (m[q][q] - m[p][p]) / (2.0 * m[p][q])
+				t := 1.0 / (Abs(theta) + Sqrt(theta*theta+1.0))
+				if theta < 0.0 {
+					t = -t
+				}
+				c := 1.0 / Sqrt(t*t+1.0)
+				s := t * c
+				for k := range 4 {
+					mkp, mkq := m[k][p], m[k][q]
+					m[k][p] = c*mkp - s*mkq
+					m[k][q] = s*mkp + c*mkq
+				}
+				for k := range 4 {
+					mpk, mqk := m[p][k], m[q][k]
+					m[p][k] = c*mpk - s*mqk
+					m[q][k] = s*mpk + c*mqk
+				}
+				for k := range 4 {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+	largest := 0
+	for i := 1; i < 4; i++ {
+		if m[i][i] > m[largest][largest] {
+			largest = i
+		}
+	}
+	return [4]float64{v[0][largest], v[1][largest], v[2][largest], v[3][largest]}
+}
@@ -0,0 +1,83 @@
+package dprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/dprec"
+	. "github.com/mokiat/gomath/testing/dprectest"
+)
+
+var _ = Describe("QuatAverage", func() {
+	var (
+		first  Quat
+		second Quat
+	)
+
+	BeforeEach(func() {
+		first = IdentityQuat()
+		second = RotationQuat(Degrees(90.0), BasisZVec3())
+	})
+
+	Specify("QuatAverage", func() {
+		result := QuatAverage([]Quat{first, second}, []float64{1.0, 1.0})
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		result = QuatAverage([]Quat{first, NegativeQuat(second)}, []float64{1.0, 1.0})
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		result = QuatAverage([]Quat{first, second}, []float64{1.0, 3.0})
+		Expect(result).To(HaveQuatCoords(0.8112421851755608, 0.0, 0.0, 0.5847102846637651))
+
+		result = QuatAverage([]Quat{NegativeQuat(first), second}, []float64{1.0, 3.0})
+		Expect(result).To(HaveQuatCoords(-0.8112421851755608, 0.0, 0.0, -0.5847102846637651))
+
+		result = QuatAverage([]Quat{second}, []float64{2.0})
+		Expect(result).To(HaveQuatCoords(second.W, second.X, second.Y, second.Z))
+	})
+
+	Specify("QuatAverage is order independent", func() {
+		quats := []Quat{
+			RotationQuat(Degrees(20.0), BasisXVec3()),
+			RotationQuat(Degrees(-30.0), BasisYVec3()),
+			NegativeQuat(RotationQuat(Degrees(45.0), NewVec3(1.0, 1.0, 1.0))),
+		}
+		weights := []float64{0.5, 0.2, 0.3}
+		expected := QuatAverage(quats, weights)
+
+		result := QuatAverage(
+			[]Quat{quats[0], quats[2], quats[1]},
+			[]float64{weights[0], weights[2], weights[1]},
+		)
+		Expect(result).To(HaveQuatCoords(expected.W, expected.X, expected.Y, expected.Z))
+
+		result = QuatFastAverage(quats, weights)
+		Expect(QuatDot(result, expected)).To(BeNumerically(">", 0.999))
+	})
+
+	Specify("QuatFastAverage", func() {
+		result := QuatFastAverage([]Quat{first, second}, []float64{1.0, 1.0})
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		result = QuatFastAverage([]Quat{first, NegativeQuat(second)}, []float64{1.0, 1.0})
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		result = QuatFastAverage([]Quat{first, second}, []float64{1.0, 3.0})
+		Expect(result).To(HaveQuatCoords(0.8270715536040013, 0.0, 0.0, 0.5620966511366738))
+	})
+
+	Specify("QuatAverage with no quaternions", func() {
+		Expect(QuatAverage(nil, nil)).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		Expect(QuatFastAverage(nil, nil)).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+	})
+
+	Specify("QuatAverage with mismatched weights", func() {
+		quats := []Quat{first, second}
+		Expect(func() {
+			QuatAverage(quats, []float64{1.0})
+		}).To(PanicWith("QuatAverage: got 1 weights for 2 quaternions"))
+		Expect(func() {
+			QuatFastAverage(quats, []float64{1.0, 1.0, 1.0})
+		}).To(PanicWith("QuatFastAverage: got 3 weights for 2 quaternions"))
+	})
+})
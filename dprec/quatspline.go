@@ -0,0 +1,119 @@
+package dprec
+
+import (
+	"slices"
+	"sort"
+)
+
+// QuatKeyframe is a key orientation of a QuatSpline at a specific time.
+//
+// The Tension, Continuity and Bias parameters control the shape of the
+// spline around the keyframe, as in a Kochanek-Bartels spline. They are
+// in the range [-1, 1] and a value of zero for all of them results in a
+// Catmull-Rom spline.
+type QuatKeyframe struct {
+	Time       float64
+	Rotation   Quat
+	Tension    float64
+	Continuity float64
+	Bias       float64
+}
+
+// NewQuatSpline creates a QuatSpline that passes through the specified
+// keyframes. The keyframes need to be sorted by time, must not share the
+// same time and their rotations need to be normalized. The slice is copied
+// and can be reused by the caller.
+func NewQuatSpline(keyframes []QuatKeyframe) QuatSpline {
+	keyframes = slices.Clone(keyframes)
+	for i := 1; i < len(keyframes); i++ {
+		if QuatDot(keyframes[i-1].Rotation, keyframes[i].Rotation) < 0.0 {
+			keyframes[i].Rotation = NegativeQuat(keyframes[i].Rotation)
+		}
+	}
+	incoming := make([]Quat, len(keyframes))
+	outgoing := make([]Quat, len(keyframes))
+	for i, keyframe := range keyframes {
+		if i == 0 || i == len(keyframes)-1 {
+			incoming[i] = keyframe.Rotation
+			outgoing[i] = keyframe.Rotation
+			continue
+		}
+		incoming[i], outgoing[i] = quatSplineControls(keyframes[i-1], keyframe, keyframes[i+1])
+	}
+	return QuatSpline{
+		keyframes: keyframes,
+		incoming:  incoming,
+		outgoing:  outgoing,
+	}
+}
+
+// QuatSpline is a smooth orientation curve through a sequence of
+// keyframes. Consecutive keyframes are interpolated with QuatSquad, using
+// control points that are derived from Kochanek-Bartels tangents.
+type QuatSpline struct {
+	keyframes []QuatKeyframe
+	incoming  []Quat
+	outgoing  []Quat
+}
+
+// Keyframes returns the keyframes of the spline. The returned slice must
+// not be modified.
+func (s QuatSpline) Keyframes() []QuatKeyframe {
+	return s.keyframes
+}
+
+// Sample returns the orientation of the spline at the specified time.
+// Times before the first keyframe or after the last one are clamped. An
+// empty spline returns the identity quaternion.
+//
+// NOTE: The result may be the negation of the respective keyframe
+// rotation, which represents the same orientation.
+func (s QuatSpline) Sample(time float64) Quat {
+	count := len(s.keyframes)
+	switch {
+	case count == 0:
+		return IdentityQuat()
+	case time <= s.keyframes[0].Time:
+		return s.keyframes[0].Rotation
+	case time >= s.keyframes[count-1].Time:
+		return s.keyframes[count-1].Rotation
+	}
+	next := sort.Search(count, func(i int) bool {
+		return s.keyframes[i].Time > time
+	})
+	current := next - 1
+	first, second := s.keyframes[current], s.keyframes[next]
+	t := (time - first.Time) / (second.Time - first.Time)
+	return QuatSquad(first.Rotation, s.outgoing[current], s.incoming[next], second.Rotation, t)
+}
+
+// quatSplineControls returns the incoming and outgoing SQUAD control points
+// of the current keyframe. The tangents are calculated in the logarithmic
+// space of the current rotation and are scaled to account for uneven
+// spacing of the keyframes in time.
+func quatSplineControls(prev, current, next QuatKeyframe) (Quat, Quat) {
+	inverse := ConjugateQuat(current.Rotation)
+	fromPrev := NegativeQuat(QuatLog(QuatProd(inverse, prev.Rotation)))
+	toNext := QuatLog(QuatProd(inverse, next.Rotation))
+
+	tension := 1.0 - current.Tension
+	continuity := current.Continuity
+	bias := current.Bias
+	prevDuration := current.Time - prev.Time
+	nextDuration := next.Time - current.Time
+	inScale := 2.0 * prevDuration / (prevDuration + nextDuration)
+	outScale := 2.0 * nextDuration / (prevDuration + nextDuration)
+
+	inTangent := quatSum(
+		QuatScalarProd(fromPrev, inScale*tension*(1.0-continuity)*(1.0+bias)/2.0),
+		QuatScalarProd(toNext, inScale*tension*(1.0+continuity)*(1.0-bias)/2.0),
+	)
+	outTangent := quatSum(
+		QuatScalarProd(fromPrev, outScale*tension*(1.0+continuity)*(1.0+bias)/2.0),
+		QuatScalarProd(toNext, outScale*tension*(1.0-continuity)*(1.0-bias)/2.0),
+	)
+
+	incoming := QuatProd(current.Rotation, QuatExp(QuatScalarProd(quatSum(fromPrev, NegativeQuat(inTangent)), 0.5)))
+	outgoing := QuatProd(current.Rotation, QuatExp(QuatScalarProd(quatSum(outTangent, NegativeQuat(toNext)), 0.5)))
+	return incoming, outgoing
+}
@@ -0,0 +1,226 @@
+package dprec
+
+import "fmt"
+
+// NewRay creates a Ray with the given origin and direction.
+func NewRay(origin, direction Vec3) Ray {
+	return Ray{
+		Origin:    origin,
+		Direction: direction,
+	}
+}
+
+// RaySphereIntersection returns the closest intersection of the ray with
+// the sphere defined by center and radius. If the ray origin is inside the
+// sphere, the point where the ray exits the sphere is returned.
+//
+// When cullBackFace is true, the sphere is only hit if the ray enters it
+// from the outside. Intersections that are further than maxDistance are
+// ignored.
+func RaySphereIntersection(ray Ray, center Vec3, radius, maxDistance float64, cullBackFace bool) (RayHit, bool) {
+	offset := Vec3Diff(ray.Origin, center)
+	a := Vec3Dot(ray.Direction, ray.Direction)
+	b := Vec3Dot(offset, ray.Direction)
+	c := Vec3Dot(offset, offset) - radius*radius
+	discriminant := b*b - a*c
+	if discriminant < 0.0 {
+		return RayHit{}, false
+	}
+	sqrtDiscriminant := Sqrt(discriminant)
+	distance := (-b - sqrtDiscriminant) / a
+	if distance < 0.0 {
+		if cullBackFace {
+			return RayHit{}, false
+		}
+		distance = (-b + sqrtDiscriminant) / a
+	}
+	if distance < 0.0 || distance > maxDistance {
+		return RayHit{}, false
+	}
+	point := ray.Point(distance)
+	return RayHit{
+		Distance: distance,
+		Point:    point,
+		Normal:   Vec3Quot(Vec3Diff(point, center), radius),
+	}, true
+}
+
+// RayAABBIntersection returns the closest intersection of the ray with the
+// specified box. If the ray origin is inside the box, the point where the
+// ray exits the box is returned. A ray whose origin lies on the surface of
+// the box and points inwards hits it at a distance of zero.
+//
+// Intersections that are further than maxDistance are ignored.
+func RayAABBIntersection(ray Ray, box AABB, maxDistance float64) (RayHit, bool) {
+	// This is the slab method. The ray is clipped against the pair of planes
+	// along each axis and the last entry and first exit are tracked.
+	var (
+		origin    = ray.Origin.Array()
+		direction = ray.Direction.Array()
+		lower     = box.Min.Array()
+		upper     = box.Max.Array()
+	)
+	var (
+		entryDistance = float64(0.0)
+		exitDistance  = maxDistance
+		entryNormal   Vec3
+		exitNormal    Vec3
+		entered       bool
+	)
+	for axis := range 3 {
+		if direction[axis] == 0.0 {
+			if origin[axis] < lower[axis] || origin[axis] > upper[axis] {
+				return RayHit{}, false
+			}
+			continue
+		}
+		invDirection := 1.0 / direction[axis]
+		near := (lower[axis] - origin[axis]) * invDirection
+		far := (upper[axis] - origin[axis]) * invDirection
+		normalSign := float64(-1.0)
+		if near > far {
+			near, far = far, near
+			normalSign = 1.0
+		}
+		if near >= entryDistance {
+			entryDistance = near
+			entryNormal = aabbAxisNormal(axis, normalSign)
+			entered = true
+		}
+		if far < exitDistance {
+			exitDistance = far
+			exitNormal = aabbAxisNormal(axis, -normalSign)
+		}
+		if entryDistance > exitDistance {
+			return RayHit{}, false
+		}
+	}
+	if entered {
+		return RayHit{
+			Distance: entryDistance,
+			Point:    ray.Point(entryDistance),
+			Normal:   entryNormal,
+		}, true
+	}
+	if exitNormal.IsZero() {
+		// The exit point lies beyond maxDistance.
+		return RayHit{}, false
+	}
+	return RayHit{
+		Distance: exitDistance,
+		Point:    ray.Point(exitDistance),
+		Normal:   exitNormal,
+	}, true
+}
+
+func aabbAxisNormal(axis int, sign float64) Vec3 {
+	var result [3]float64
+	result[axis] = sign
+	return ArrayToVec3(result)
+}
+
+// RayPlaneIntersection returns the intersection of the ray with the
+// specified plane. The returned normal is always the plane normal.
+//
+// When cullBackFace is true, the plane is only hit if the ray approaches it
+// from the side the normal is pointing to. Intersections that are further
+// than maxDistance are ignored.
+func RayPlaneIntersection(ray Ray, plane Plane, maxDistance float64, cullBackFace bool) (RayHit, bool) {
+	denominator := Vec3Dot(plane.Normal, ray.Direction)
+	if Abs(denominator) < Epsilon {
+		return RayHit{}, false
+	}
+	if cullBackFace && denominator > 0.0 {
+		return RayHit{}, false
+	}
+	distance := -plane.SignedDistance(ray.Origin) / denominator
+	if distance < 0.0 || distance > maxDistance {
+		return RayHit{}, false
+	}
+	return RayHit{
+		Distance: distance,
+		Point:    ray.Point(distance),
+		Normal:   plane.Normal,
+	}, true
+}
+
+// RayTriangleIntersection returns the intersection of the ray with the
+// triangle defined by the vertices a, b and c. The front face of the
+// triangle is the one where the vertices appear in counter-clockwise order
+// and the returned normal is always the unit normal of the front face.
+//
+// When cullBackFace is true, the triangle is only hit if the ray approaches
+// it from the front. Intersections that are further than maxDistance are
+// ignored.
+func RayTriangleIntersection(ray Ray, a, b, c Vec3, maxDistance float64, cullBackFace bool) (RayHit, bool) {
+	// This is the Möller–Trumbore algorithm.
+	// See https://en.wikipedia.org/wiki/M%C3%B6ller%E2%80%93Trumbore_intersection_algorithm
+	edge1 := Vec3Diff(b, a)
+	edge2 := Vec3Diff(c, a)
+	pVector := Vec3Cross(ray.Direction, edge2)
+	determinant := Vec3Dot(edge1, pVector)
+	if cullBackFace && determinant < Epsilon {
+		return RayHit{}, false
+	}
+	if Abs(determinant) < Epsilon {
+		return RayHit{}, false
+	}
+	invDeterminant := 1.0 / determinant
+	tVector := Vec3Diff(ray.Origin, a)
+	u := Vec3Dot(tVector, pVector) * invDeterminant
+	if u < 0.0 || u > 1.0 {
+		return RayHit{}, false
+	}
+	qVector := Vec3Cross(tVector, edge1)
+	v := Vec3Dot(ray.Direction, qVector) * invDeterminant
+	if v < 0.0 || u+v > 1.0 {
+		return RayHit{}, false
+	}
+	distance := Vec3Dot(edge2, qVector) * invDeterminant
+	if distance < 0.0 || distance > maxDistance {
+		return RayHit{}, false
+	}
+	return RayHit{
+		Distance: distance,
+		Point:    ray.Point(distance),
+		Normal:   UnitVec3(Vec3Cross(edge1, edge2)),
+	}, true
+}
+
+// Ray represents a half-line that starts at Origin and extends infinitely
+// along Direction.
+//
+// Distances along the ray are measured in multiples of the Direction
+// length. Use a unit Direction for distances to be in world units.
+type Ray struct {
+	Origin    Vec3
+	Direction Vec3
+}
+
+// IsNaN returns true if any component is NaN.
+func (r Ray) IsNaN() bool {
+	return r.Origin.IsNaN() || r.Direction.IsNaN()
+}
+
+// Point returns the point that is at the specified distance along the ray.
+func (r Ray) Point(distance float64) Vec3 {
+	return Vec3Sum(r.Origin, Vec3Prod(r.Direction, distance))
+}
+
+// String returns a string representation of the ray.
+func (r Ray) String() string {
+	return fmt.Sprintf("(%s, %s)", r.Origin, r.Direction)
+}
+
+// RayHit describes the intersection of a Ray with a shape.
+type RayHit struct {
+	// Distance is the distance along the ray at which the intersection
+	// occurred.
+	Distance float64
+
+	// Point is the point of intersection.
+	Point Vec3
+
+	// Normal is the unit surface normal at the point of intersection.
+	Normal Vec3
+}
@@ -0,0 +1,195 @@
+package dprec
+
+import "github.com/mokiat/gomath/gen"
+
+// ParseVec2 parses a Vec2 from text of the form "(x, y)", which is the
+// format produced by String and MarshalText.
+func ParseVec2(text string) (Vec2, error) {
+	result, err := gen.ParseVec2[float64](text)
+	return Vec2(result), err
+}
+
+// ParseVec3 parses a Vec3 from text of the form "(x, y, z)", which is the
+// format produced by String and MarshalText.
+func ParseVec3(text string) (Vec3, error) {
+	result, err := gen.ParseVec3[float64](text)
+	return Vec3(result), err
+}
+
+// ParseVec4 parses a Vec4 from text of the form "(x, y, z, w)", which is
+// the format produced by String and MarshalText.
+func ParseVec4(text string) (Vec4, error) {
+	result, err := gen.ParseVec4[float64](text)
+	return Vec4(result), err
+}
+
+// ParseQuat parses a Quat from text of the form "(w, x, y, z)", which is
+// the format produced by String and MarshalText.
+func ParseQuat(text string) (Quat, error) {
+	result, err := gen.ParseQuat[float64](text)
+	return Quat(result), err
+}
+
+// ParseMat2 parses a Mat2 from text that lists the rows of the matrix in
+// the form "((m11, m12), (m21, m22))", which is the format produced by
+// String and MarshalText.
+func ParseMat2(text string) (Mat2, error) {
+	result, err := gen.ParseMat2[float64](text)
+	return Mat2(result), err
+}
+
+// ParseMat3 parses a Mat3 from text that lists the rows of the matrix in
+// the form "((m11, m12, m13), (m21, m22, m23), (m31, m32, m33))", which is
+// the format produced by String and MarshalText.
+func ParseMat3(text string) (Mat3, error) {
+	result, err := gen.ParseMat3[float64](text)
+	return Mat3(result), err
+}
+
+// ParseMat4 parses a Mat4 from text that lists the rows of the matrix in
+// the form "((m11, m12, m13, m14), ..., (m41, m42, m43, m44))", which is
+// the format produced by String and MarshalText.
+func ParseMat4(text string) (Mat4, error) {
+	result, err := gen.ParseMat4[float64](text)
+	return Mat4(result), err
+}
+
+// ParseAngle parses an angle from text that is a plain number of radians or
+// a number with a "deg" or "rad" unit suffix (e.g. "90deg" or "1.5 rad").
+func ParseAngle(text string) (Angle, error) {
+	radians, err := gen.ParseAngle[float64](text)
+	return Angle(radians), err
+}
+
+// AppendText implements encoding.TextAppender. The angle is encoded as the
+// number of radians followed by a "rad" unit suffix.
+func (a Angle) AppendText(data []byte) ([]byte, error) {
+	return gen.AppendAngleText(data, float64(a)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a Angle) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *Angle) UnmarshalText(text []byte) error {
+	result, err := ParseAngle(string(text))
+	if err != nil {
+		return err
+	}
+	*a = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec2) AppendText(data []byte) ([]byte, error) {
+	return gen.Vec2[float64](v).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec2) MarshalText() ([]byte, error) {
+	return gen.Vec2[float64](v).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec2) UnmarshalText(text []byte) error {
+	return (*gen.Vec2[float64])(v).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec3) AppendText(data []byte) ([]byte, error) {
+	return gen.Vec3[float64](v).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec3) MarshalText() ([]byte, error) {
+	return gen.Vec3[float64](v).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec3) UnmarshalText(text []byte) error {
+	return (*gen.Vec3[float64])(v).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec4) AppendText(data []byte) ([]byte, error) {
+	return gen.Vec4[float64](v).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec4) MarshalText() ([]byte, error) {
+	return gen.Vec4[float64](v).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec4) UnmarshalText(text []byte) error {
+	return (*gen.Vec4[float64])(v).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (q Quat) AppendText(data []byte) ([]byte, error) {
+	return gen.Quat[float64](q).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (q Quat) MarshalText() ([]byte, error) {
+	return gen.Quat[float64](q).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (q *Quat) UnmarshalText(text []byte) error {
+	return (*gen.Quat[float64])(q).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat2) AppendText(data []byte) ([]byte, error) {
+	return gen.Mat2[float64](m).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat2) MarshalText() ([]byte, error) {
+	return gen.Mat2[float64](m).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat2) UnmarshalText(text []byte) error {
+	return (*gen.Mat2[float64])(m).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat3) AppendText(data []byte) ([]byte, error) {
+	return gen.Mat3[float64](m).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat3) MarshalText() ([]byte, error) {
+	return gen.Mat3[float64](m).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat3) UnmarshalText(text []byte) error {
+	return (*gen.Mat3[float64])(m).UnmarshalText(text)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat4) AppendText(data []byte) ([]byte, error) {
+	return gen.Mat4[float64](m).AppendText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat4) MarshalText() ([]byte, error) {
+	return gen.Mat4[float64](m).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat4) UnmarshalText(text []byte) error {
+	return (*gen.Mat4[float64])(m).UnmarshalText(text)
+}
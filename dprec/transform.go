@@ -0,0 +1,139 @@
+package dprec
+
+import "fmt"
+
+// NewTransform creates a Transform with the given translation, rotation
+// and scale.
+func NewTransform(translation Vec3, rotation Quat, scale Vec3) Transform {
+	return Transform{
+		Translation: translation,
+		Rotation:    rotation,
+		Scale:       scale,
+	}
+}
+
+// IdentityTransform returns the identity transform (no translation, no
+// rotation and unit scale).
+func IdentityTransform() Transform {
+	return Transform{
+		Rotation: IdentityQuat(),
+		Scale:    NewVec3(1.0, 1.0, 1.0),
+	}
+}
+
+// Mat4ToTransform decomposes the matrix into a Transform, using Mat4.TRS.
+//
+// NOTE: Matrices that contain shear, for example as a result of rotating a
+// non-uniformly scaled child, cannot be represented exactly.
+func Mat4ToTransform(m Mat4) Transform {
+	translation, rotation, scale := m.TRS()
+	return Transform{
+		Translation: translation,
+		Rotation:    rotation,
+		Scale:       scale,
+	}
+}
+
+// TransformProd returns the composition of two transforms. Like Mat4Prod,
+// the resulting transform applies second and then first, which means that
+// first is the parent and second is the child in a transform hierarchy.
+//
+// NOTE: The result is exact only if the scale of first is uniform or if
+// the rotation of second is aligned with the axes of first. Otherwise, the
+// product contains shear, which a Transform cannot represent.
+func TransformProd(first, second Transform) Transform {
+	return Transform{
+		Translation: TransformPoint(first, second.Translation),
+		Rotation:    QuatProd(first.Rotation, second.Rotation),
+		Scale:       vec3ComponentProd(first.Scale, second.Scale),
+	}
+}
+
+// InverseTransform returns the transform that reverses the specified one.
+//
+// NOTE: The result is exact only if the scale is uniform, for the same
+// reason as with TransformProd. The scale must not have zero components.
+func InverseTransform(t Transform) Transform {
+	rotation := ConjugateQuat(UnitQuat(t.Rotation))
+	scale := vec3ComponentQuot(NewVec3(1.0, 1.0, 1.0), t.Scale)
+	translation := vec3ComponentProd(scale, QuatVec3Rotation(rotation, t.Translation))
+	return Transform{
+		Translation: InverseVec3(translation),
+		Rotation:    rotation,
+		Scale:       scale,
+	}
+}
+
+// TransformPoint applies the scale, rotation and translation of the
+// transform to the specified point, in that order.
+func TransformPoint(t Transform, point Vec3) Vec3 {
+	return Vec3Sum(TransformDirection(t, point), t.Translation)
+}
+
+// TransformDirection applies the scale and rotation of the transform to
+// the specified direction vector. The translation is not applied and the
+// result is not normalized.
+func TransformDirection(t Transform, direction Vec3) Vec3 {
+	return QuatVec3Rotation(t.Rotation, vec3ComponentProd(t.Scale, direction))
+}
+
+// TransformNormal transforms the specified surface normal, so that it
+// remains perpendicular to the transformed surface even when the scale is
+// not uniform. The result is a unit vector.
+func TransformNormal(t Transform, normal Vec3) Vec3 {
+	return UnitVec3(QuatVec3Rotation(t.Rotation, vec3ComponentQuot(normal, t.Scale)))
+}
+
+// TransformLerp interpolates between first and second using t, where the
+// translation and scale are interpolated linearly and the rotation is
+// interpolated spherically. A value of t=0 returns first and t=1 returns
+// second.
+func TransformLerp(first, second Transform, t float64) Transform {
+	return Transform{
+		Translation: Vec3Lerp(first.Translation, second.Translation, t),
+		Rotation:    QuatSlerp(first.Rotation, second.Rotation, t),
+		Scale:       Vec3Lerp(first.Scale, second.Scale, t),
+	}
+}
+
+// Transform represents a transformation that is stored as separate
+// translation, rotation and scale components. When applied, the scale is
+// applied first, followed by the rotation and the translation.
+//
+// Compared to Mat4, this representation does not accumulate skew or scale
+// drift from repeated products and can be interpolated directly.
+type Transform struct {
+	Translation Vec3
+	Rotation    Quat
+	Scale       Vec3
+}
+
+// IsNaN returns true if any component is NaN.
+func (t Transform) IsNaN() bool {
+	return t.Translation.IsNaN() || t.Rotation.IsNaN() || t.Scale.IsNaN()
+}
+
+// IsIdentity returns true if this is the identity transform.
+func (t Transform) IsIdentity() bool {
+	return t.Translation.IsZero() && t.Rotation.IsIdentity() &&
+		Eq(t.Scale.X, 1.0) && Eq(t.Scale.Y, 1.0) && Eq(t.Scale.Z, 1.0)
+}
+
+// Mat4 returns the transformation matrix that is equivalent to the
+// transform.
+func (t Transform) Mat4() Mat4 {
+	return TRSMat4(t.Translation, t.Rotation, t.Scale)
+}
+
+// String returns a string representation of the transform.
+func (t Transform) String() string {
+	return fmt.Sprintf("(%s, %s, %s)", t.Translation, t.Rotation, t.Scale)
+}
+
+func vec3ComponentProd(a, b Vec3) Vec3 {
+	return NewVec3(a.X*b.X, a.Y*b.Y, a.Z*b.Z)
+}
+
+func vec3ComponentQuot(a, b Vec3) Vec3 {
+	return NewVec3(a.X/b.X, a.Y/b.Y, a.Z/b.Z)
+}
@@ -0,0 +1,181 @@
+package dprec
+
+import "fmt"
+
+// NewVec3Slice creates a Vec3Slice that holds length zero vectors.
+func NewVec3Slice(length int) Vec3Slice {
+	return Vec3Slice{
+		X: make([]float64, length),
+		Y: make([]float64, length),
+		Z: make([]float64, length),
+	}
+}
+
+// NewVec3SliceFromVec3s creates a Vec3Slice that holds a copy of the
+// specified vectors.
+func NewVec3SliceFromVec3s(vectors []Vec3) Vec3Slice {
+	result := NewVec3Slice(len(vectors))
+	for i, vector := range vectors {
+		result.X[i] = vector.X
+		result.Y[i] = vector.Y
+		result.Z[i] = vector.Z
+	}
+	return result
+}
+
+// Vec3Slice is a sequence of Vec3 values that are stored as separate
+// slices of X, Y and Z components (structure of arrays). This makes
+// operations that process many vectors or only some of the components
+// more cache-friendly than a []Vec3.
+//
+// The three slices need to have the same length. The bulk operations modify
+// the vectors in place and panic if this is not the case or if the other
+// slices that are passed to them are shorter than the receiver.
+type Vec3Slice struct {
+	X []float64
+	Y []float64
+	Z []float64
+}
+
+// Len returns the number of vectors.
+func (s Vec3Slice) Len() int {
+	return len(s.X)
+}
+
+// At returns the vector at the specified index.
+func (s Vec3Slice) At(index int) Vec3 {
+	return NewVec3(s.X[index], s.Y[index], s.Z[index])
+}
+
+// Set changes the vector at the specified index.
+func (s Vec3Slice) Set(index int, vector Vec3) {
+	s.X[index] = vector.X
+	s.Y[index] = vector.Y
+	s.Z[index] = vector.Z
+}
+
+// AppendVec3s appends the vectors to the specified slice and returns the
+// extended slice.
+func (s Vec3Slice) AppendVec3s(vectors []Vec3) []Vec3 {
+	x, y, z := s.components()
+	for i := range x {
+		vectors = append(vectors, NewVec3(x[i], y[i], z[i]))
+	}
+	return vectors
+}
+
+// Add adds the respective vectors of other to the vectors.
+func (s Vec3Slice) Add(other Vec3Slice) {
+	x, y, z := s.components()
+	ox, oy, oz := other.prefix(len(x))
+	for i := range x {
+		x[i] += ox[i]
+		y[i] += oy[i]
+		z[i] += oz[i]
+	}
+}
+
+// AddScaled adds the respective vectors of other multiplied by scale to
+// the vectors (e.g. to integrate positions from velocities).
+func (s Vec3Slice) AddScaled(other Vec3Slice, scale float64) {
+	x, y, z := s.components()
+	ox, oy, oz := other.prefix(len(x))
+	for i := range x {
+		x[i] += ox[i] * scale
+		y[i] += oy[i] * scale
+		z[i] += oz[i] * scale
+	}
+}
+
+// Scale multiplies the vectors by value.
+func (s Vec3Slice) Scale(value float64) {
+	x, y, z := s.components()
+	for i := range x {
+		x[i] *= value
+		y[i] *= value
+		z[i] *= value
+	}
+}
+
+// Dot stores the dot products of the vectors and the respective vectors of
+// other into dst.
+func (s Vec3Slice) Dot(other Vec3Slice, dst []float64) {
+	x, y, z := s.components()
+	ox, oy, oz := other.prefix(len(x))
+	dst = vec3SliceDst(dst, len(x))
+	for i := range x {
+		dst[i] = x[i]*ox[i] + y[i]*oy[i] + z[i]*oz[i]
+	}
+}
+
+// Length stores the lengths of the vectors into dst.
+func (s Vec3Slice) Length(dst []float64) {
+	x, y, z := s.components()
+	dst = vec3SliceDst(dst, len(x))
+	for i := range x {
+		dst[i] = Sqrt(x[i]*x[i] + y[i]*y[i] + z[i]*z[i])
+	}
+}
+
+// Normalize scales the vectors to unit length.
+//
+// The behavior is undefined for zero-length vectors.
+func (s Vec3Slice) Normalize() {
+	x, y, z := s.components()
+	for i := range x {
+		length := Sqrt(x[i]*x[i] + y[i]*y[i] + z[i]*z[i])
+		x[i] /= length
+		y[i] /= length
+		z[i] /= length
+	}
+}
+
+// Lerp moves the vectors towards the respective vectors of other by the
+// amount t, as with Vec3Lerp.
+func (s Vec3Slice) Lerp(other Vec3Slice, t float64) {
+	x, y, z := s.components()
+	ox, oy, oz := other.prefix(len(x))
+	for i := range x {
+		x[i] += (ox[i] - x[i]) * t
+		y[i] += (oy[i] - y[i]) * t
+		z[i] += (oz[i] - z[i]) * t
+	}
+}
+
+// Transform applies the 3D affine transformation to the vectors, as with
+// Mat4Vec3Transformation.
+func (s Vec3Slice) Transform(mat Mat4) {
+	x, y, z := s.components()
+	for i := range x {
+		vx, vy, vz := x[i], y[i], z[i]
+		x[i] = mat.M11*vx + mat.M12*vy + mat.M13*vz + mat.M14
+		y[i] = mat.M21*vx + mat.M22*vy + mat.M23*vz + mat.M24
+		z[i] = mat.M31*vx + mat.M32*vy + mat.M33*vz + mat.M34
+	}
+}
+
+// components returns the component slices, resliced to the same length so
+// that indexing within the loops does not require bounds checks.
+func (s Vec3Slice) components() ([]float64, []float64, []float64) {
+	if len(s.Y) != len(s.X) || len(s.Z) != len(s.X) {
+		panic(fmt.Sprintf("Vec3Slice components have different lengths (%d, %d, %d)", len(s.X), len(s.Y), len(s.Z)))
+	}
+	return s.X, s.Y[:len(s.X)], s.Z[:len(s.X)]
+}
+
+// prefix returns the component slices, resliced to the specified length.
+func (s Vec3Slice) prefix(length int) ([]float64, []float64, []float64) {
+	x, y, z := s.components()
+	if len(x) < length {
+		panic(fmt.Sprintf("Vec3Slice has %d vectors but %d are required", len(x), length))
+	}
+	return x[:length], y[:length], z[:length]
+}
+
+// vec3SliceDst returns dst, resliced to the specified length.
+func vec3SliceDst(dst []float64, length int) []float64 {
+	if len(dst) < length {
+		panic(fmt.Sprintf("Vec3Slice destination has %d elements but %d are required", len(dst), length))
+	}
+	return dst[:length]
+}
@@ -1,160 +1,120 @@
 package dprec
 
-import (
-	"fmt"
-	"math"
-)
+import "github.com/mokiat/gomath/gen"
 
 // NewVec4 creates a Vec4 with the given X, Y, Z, and W components.
 func NewVec4(x, y, z, w float64) Vec4 {
-	return Vec4{
-		X: x,
-		Y: y,
-		Z: z,
-		W: w,
-	}
+	return Vec4(gen.NewVec4(x, y, z, w))
 }
 
 // ZeroVec4 returns the zero Vec4.
 func ZeroVec4() Vec4 {
-	return Vec4{}
+	return Vec4(gen.ZeroVec4[float64]())
 }
 
 // Vec4Sum returns the sum of two vectors.
 func Vec4Sum(a, b Vec4) Vec4 {
-	return Vec4{
-		X: a.X + b.X,
-		Y: a.Y + b.Y,
-		Z: a.Z + b.Z,
-		W: a.W + b.W,
-	}
+	return Vec4(gen.Vec4Sum(gen.Vec4[float64](a), gen.Vec4[float64](b)))
 }
 
 // Vec4MultiSum returns the sum of multiple vectors.
 func Vec4MultiSum(first Vec4, others ...Vec4) Vec4 {
 	result := first
 	for _, other := range others {
-		result.X += other.X
-		result.Y += other.Y
-		result.Z += other.Z
-		result.W += other.W
+		result = Vec4Sum(result, other)
 	}
 	return result
 }
 
 // Vec4Diff returns the difference of two vectors (a - b).
 func Vec4Diff(a, b Vec4) Vec4 {
-	return Vec4{
-		X: a.X - b.X,
-		Y: a.Y - b.Y,
-		Z: a.Z - b.Z,
-		W: a.W - b.W,
-	}
+	return Vec4(gen.Vec4Diff(gen.Vec4[float64](a), gen.Vec4[float64](b)))
 }
 
 // Vec4MultiDiff subtracts each subsequent vector from the first.
 func Vec4MultiDiff(first Vec4, others ...Vec4) Vec4 {
 	result := first
 	for _, other := range others {
-		result.X -= other.X
-		result.Y -= other.Y
-		result.Z -= other.Z
-		result.W -= other.W
+		result = Vec4Diff(result, other)
 	}
 	return result
 }
 
 // Vec4Prod multiplies a vector by a scalar value.
 func Vec4Prod(vector Vec4, value float64) Vec4 {
-	return Vec4{
-		X: vector.X * value,
-		Y: vector.Y * value,
-		Z: vector.Z * value,
-		W: vector.W * value,
-	}
+	return Vec4(gen.Vec4Prod(gen.Vec4[float64](vector), value))
 }
 
 // Vec4Quot divides a vector by a scalar value.
 func Vec4Quot(vector Vec4, value float64) Vec4 {
-	invValue := 1.0 / value
-	return Vec4{
-		X: vector.X * invValue,
-		Y: vector.Y * invValue,
-		Z: vector.Z * invValue,
-		W: vector.W * invValue,
-	}
+	return Vec4(gen.Vec4Quot(gen.Vec4[float64](vector), value))
 }
 
 // Vec4Dot returns the dot product of two vectors.
 func Vec4Dot(a, b Vec4) float64 {
-	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
+	return gen.Vec4Dot(gen.Vec4[float64](a), gen.Vec4[float64](b))
 }
 
 // Vec4Lerp returns the linear interpolation between a and b using t.
 // A value of t=0 returns a and t=1 returns b.
 func Vec4Lerp(a, b Vec4, t float64) Vec4 {
-	return Vec4{
-		X: a.X + t*(b.X-a.X),
-		Y: a.Y + t*(b.Y-a.Y),
-		Z: a.Z + t*(b.Z-a.Z),
-		W: a.W + t*(b.W-a.W),
-	}
+	return Vec4(gen.Vec4Lerp(gen.Vec4[float64](a), gen.Vec4[float64](b), t))
 }
 
 // InverseVec4 returns the negation of the given vector.
 func InverseVec4(vector Vec4) Vec4 {
-	return Vec4{
-		X: -vector.X,
-		Y: -vector.Y,
-		Z: -vector.Z,
-		W: -vector.W,
-	}
+	return Vec4(gen.InverseVec4(gen.Vec4[float64](vector)))
+}
+
+// UnitVec4 returns the unit (normalized) vector in the direction of vector.
+func UnitVec4(vector Vec4) Vec4 {
+	return Vec4(gen.UnitVec4(gen.Vec4[float64](vector)))
 }
 
 // ArrayToVec4 creates a Vec4 from a four-element array.
 func ArrayToVec4(array [4]float64) Vec4 {
-	return Vec4{
-		X: array[0],
-		Y: array[1],
-		Z: array[2],
-		W: array[3],
-	}
+	return Vec4(gen.ArrayToVec4(array))
 }
 
 // Vec4 is a four-dimensional vector with float64 components.
-type Vec4 struct {
-	X float64
-	Y float64
-	Z float64
-	W float64
-}
+type Vec4 gen.Vec4[float64]
 
 // IsNaN returns true if any component is NaN.
 func (v Vec4) IsNaN() bool {
-	return math.IsNaN(v.X) || math.IsNaN(v.Y) || math.IsNaN(v.Z) || math.IsNaN(v.W)
+	return gen.Vec4[float64](v).IsNaN()
 }
 
 // IsInf returns true if any component is Inf.
 func (v Vec4) IsInf() bool {
-	return math.IsInf(v.X, 0) || math.IsInf(v.Y, 0) || math.IsInf(v.Z, 0) || math.IsInf(v.W, 0)
+	return gen.Vec4[float64](v).IsInf()
 }
 
 // IsZero returns true if all components are within Epsilon of zero.
 func (v Vec4) IsZero() bool {
-	return Eq(v.X, 0.0) && Eq(v.Y, 0.0) && Eq(v.Z, 0.0) && Eq(v.W, 0.0)
+	return gen.Vec4[float64](v).IsZero()
+}
+
+// SqrLength returns the squared length of the vector.
+func (v Vec4) SqrLength() float64 {
+	return gen.Vec4[float64](v).SqrLength()
+}
+
+// Length returns the length of the vector.
+func (v Vec4) Length() float64 {
+	return gen.Vec4[float64](v).Length()
 }
 
 // VecXYZ returns the X, Y, and Z components as a Vec3.
 func (v Vec4) VecXYZ() Vec3 {
-	return NewVec3(v.X, v.Y, v.Z)
+	return Vec3(gen.Vec4[float64](v).VecXYZ())
 }
 
 // Array returns the vector components as an array.
 func (v Vec4) Array() [4]float64 {
-	return [4]float64{v.X, v.Y, v.Z, v.W}
+	return gen.Vec4[float64](v).Array()
 }
 
 // String returns a string representation of the vector.
 func (v Vec4) String() string {
-	return fmt.Sprintf("(%f, %f, %f, %f)", v.X, v.Y, v.Z, v.W)
+	return gen.Vec4[float64](v).String()
 }
@@ -0,0 +1,114 @@
+package dprec
+
+import "fmt"
+
+// NewViewport creates a Viewport with the given position and size in
+// pixels.
+func NewViewport(x, y, width, height float64) Viewport {
+	return Viewport{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// ProjectVec3 transforms the specified world-space point by the
+// view-projection matrix and maps it to the viewport. The X and Y
+// components of the result are in pixels and the Z component is the window
+// depth, which is always in the [0, 1] range for points between the near
+// and far planes.
+//
+// If the point is behind the camera, the result is meaningless and false
+// is returned.
+func ProjectVec3(viewProjection Mat4, viewport Viewport, mapping DepthMapping, point Vec3) (Vec3, bool) {
+	clip := Mat4Vec4Prod(viewProjection, NewVec4(point.X, point.Y, point.Z, 1.0))
+	if clip.W <= 0.0 {
+		return Vec3{}, false
+	}
+	ndc := Vec3Quot(clip.VecXYZ(), clip.W)
+	return viewport.ndcToWindow(ndc, mapping), true
+}
+
+// UnprojectVec3 is the inverse of ProjectVec3. It maps the specified
+// window coordinates (pixels and window depth) back to a world-space point.
+//
+// The view-projection matrix is inverted on each call. Consider using
+// InverseUnprojectVec3 when unprojecting many points with the same matrix.
+func UnprojectVec3(viewProjection Mat4, viewport Viewport, mapping DepthMapping, window Vec3) Vec3 {
+	return InverseUnprojectVec3(InverseMat4(viewProjection), viewport, mapping, window)
+}
+
+// InverseUnprojectVec3 is like UnprojectVec3 except that it takes the
+// already inverted view-projection matrix.
+func InverseUnprojectVec3(inverseViewProjection Mat4, viewport Viewport, mapping DepthMapping, window Vec3) Vec3 {
+	ndc := viewport.windowToNDC(window, mapping)
+	world := Mat4Vec4Prod(inverseViewProjection, NewVec4(ndc.X, ndc.Y, ndc.Z, 1.0))
+	return Vec3Quot(world.VecXYZ(), world.W)
+}
+
+// PixelRay returns the world-space ray that starts on the near plane and
+// passes through the specified pixel coordinates. The direction of the ray
+// is a unit vector.
+//
+// This works for projections with an infinite far plane as well.
+func PixelRay(viewProjection Mat4, viewport Viewport, mapping DepthMapping, x, y float64) Ray {
+	inverseViewProjection := InverseMat4(viewProjection)
+	nearDepth := float64(0.0)
+	if mapping.Reversed {
+		nearDepth = 1.0
+	}
+	// The second point is taken halfway through the depth range instead
+	// of on the far plane, since the latter can be at infinity.
+	nearPoint := InverseUnprojectVec3(inverseViewProjection, viewport, mapping, NewVec3(x, y, nearDepth))
+	midPoint := InverseUnprojectVec3(inverseViewProjection, viewport, mapping, NewVec3(x, y, 0.5))
+	return Ray{
+		Origin:    nearPoint,
+		Direction: UnitVec3(Vec3Diff(midPoint, nearPoint)),
+	}
+}
+
+// Viewport represents a rectangular area of the screen, in pixels.
+//
+// Pixel coordinates follow the window system convention, where the origin
+// is at the top-left corner of the screen and the Y axis points down.
+type Viewport struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// AspectRatio returns the width of the viewport divided by its height.
+func (v Viewport) AspectRatio() float64 {
+	return v.Width / v.Height
+}
+
+// String returns a string representation of the viewport.
+func (v Viewport) String() string {
+	return fmt.Sprintf("(%f, %f, %f, %f)", v.X, v.Y, v.Width, v.Height)
+}
+
+func (v Viewport) ndcToWindow(ndc Vec3, mapping DepthMapping) Vec3 {
+	depth := ndc.Z
+	if mapping.Range == DepthRangeNegativeOneToOne {
+		depth = (depth + 1.0) * 0.5
+	}
+	return Vec3{
+		X: v.X + (ndc.X+1.0)*0.5*v.Width,
+		Y: v.Y + (1.0-ndc.Y)*0.5*v.Height,
+		Z: depth,
+	}
+}
+
+func (v Viewport) windowToNDC(window Vec3, mapping DepthMapping) Vec3 {
+	depth := window.Z
+	if mapping.Range == DepthRangeNegativeOneToOne {
+		depth = depth*2.0 - 1.0
+	}
+	return Vec3{
+		X: 2.0*(window.X-v.X)/v.Width - 1.0,
+		Y: 1.0 - 2.0*(window.Y-v.Y)/v.Height,
+		Z: depth,
+	}
+}
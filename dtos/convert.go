@@ -52,6 +52,18 @@ func Quat(src dprec.Quat) sprec.Quat {
 	}
 }
 
+// Mat2 converts the specified double-precision Mat2 into a single-precision
+// Mat2.
+func Mat2(src dprec.Mat2) sprec.Mat2 {
+	return sprec.Mat2{
+		M11: float32(src.M11),
+		M12: float32(src.M12),
+
+		M21: float32(src.M21),
+		M22: float32(src.M22),
+	}
+}
+
 // Mat3 converts the specified double-precision Mat3 into a single-precision
 // Mat3.
 func Mat3(src dprec.Mat3) sprec.Mat3 {
@@ -95,3 +107,49 @@ func Mat4(src dprec.Mat4) sprec.Mat4 {
 		M44: float32(src.M44),
 	}
 }
+
+// AABB converts the specified double-precision AABB into a single-precision
+// AABB.
+func AABB(src dprec.AABB) sprec.AABB {
+	return sprec.AABB{
+		Min: Vec3(src.Min),
+		Max: Vec3(src.Max),
+	}
+}
+
+// Ray converts the specified double-precision Ray into a single-precision
+// Ray.
+func Ray(src dprec.Ray) sprec.Ray {
+	return sprec.Ray{
+		Origin:    Vec3(src.Origin),
+		Direction: Vec3(src.Direction),
+	}
+}
+
+// Plane converts the specified double-precision Plane into a
+// single-precision Plane.
+func Plane(src dprec.Plane) sprec.Plane {
+	return sprec.Plane{
+		Normal:   Vec3(src.Normal),
+		Distance: float32(src.Distance),
+	}
+}
+
+// DualQuat converts the specified double-precision DualQuat into a
+// single-precision DualQuat.
+func DualQuat(src dprec.DualQuat) sprec.DualQuat {
+	return sprec.DualQuat{
+		Real: Quat(src.Real),
+		Dual: Quat(src.Dual),
+	}
+}
+
+// Transform converts the specified double-precision Transform into a
+// single-precision Transform.
+func Transform(src dprec.Transform) sprec.Transform {
+	return sprec.Transform{
+		Translation: Vec3(src.Translation),
+		Rotation:    Quat(src.Rotation),
+		Scale:       Vec3(src.Scale),
+	}
+}
@@ -0,0 +1,166 @@
+package gen
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y.
+func (v Vec2[T]) AppendBinary(data []byte) ([]byte, error) {
+	return appendBinaryFloats(data, v.X, v.Y), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec2[T]) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec2[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Vec2", &v.X, &v.Y)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y, Z.
+func (v Vec3[T]) AppendBinary(data []byte) ([]byte, error) {
+	return appendBinaryFloats(data, v.X, v.Y, v.Z), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec3[T]) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec3[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Vec3", &v.X, &v.Y, &v.Z)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The vector is encoded
+// as the little-endian components X, Y, Z, W.
+func (v Vec4[T]) AppendBinary(data []byte) ([]byte, error) {
+	return appendBinaryFloats(data, v.X, v.Y, v.Z, v.W), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Vec4[T]) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Vec4[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Vec4", &v.X, &v.Y, &v.Z, &v.W)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The quaternion is
+// encoded as the little-endian components W, X, Y, Z, following the
+// argument order of NewQuat. Note that the PackBuffer functions of the
+// sprec and dprec packages use the X, Y, Z, W order of shaders instead.
+func (q Quat[T]) AppendBinary(data []byte) ([]byte, error) {
+	return appendBinaryFloats(data, q.W, q.X, q.Y, q.Z), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (q Quat[T]) MarshalBinary() ([]byte, error) {
+	return q.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (q *Quat[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Quat", &q.W, &q.X, &q.Y, &q.Z)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat2[T]) AppendBinary(data []byte) ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return appendBinaryFloats(data, values[:]...), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat2[T]) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat2[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Mat2",
+		&m.M11, &m.M21,
+		&m.M12, &m.M22,
+	)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat3[T]) AppendBinary(data []byte) ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return appendBinaryFloats(data, values[:]...), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat3[T]) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat3[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Mat3",
+		&m.M11, &m.M21, &m.M31,
+		&m.M12, &m.M22, &m.M32,
+		&m.M13, &m.M23, &m.M33,
+	)
+}
+
+// AppendBinary implements encoding.BinaryAppender. The matrix is encoded
+// as little-endian components in column-major order, matching
+// ColumnMajorArray.
+func (m Mat4[T]) AppendBinary(data []byte) ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return appendBinaryFloats(data, values[:]...), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mat4[T]) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Mat4[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryFloats(data, "Mat4",
+		&m.M11, &m.M21, &m.M31, &m.M41,
+		&m.M12, &m.M22, &m.M32, &m.M42,
+		&m.M13, &m.M23, &m.M33, &m.M43,
+		&m.M14, &m.M24, &m.M34, &m.M44,
+	)
+}
+
+func appendBinaryFloats[T Float](data []byte, values ...T) []byte {
+	for _, value := range values {
+		if bitSize[T]() == 32 {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(value)))
+		} else {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(float64(value)))
+		}
+	}
+	return data
+}
+
+func unmarshalBinaryFloats[T Float](data []byte, typeName string, elements ...*T) error {
+	size := bitSize[T]() / 8
+	if expected := len(elements) * size; len(data) != expected {
+		return fmt.Errorf("invalid %s binary data: expected %d bytes but got %d", typeName, expected, len(data))
+	}
+	for i, element := range elements {
+		chunk := data[i*size:]
+		if size == 4 {
+			*element = T(math.Float32frombits(binary.LittleEndian.Uint32(chunk)))
+		} else {
+			*element = T(math.Float64frombits(binary.LittleEndian.Uint64(chunk)))
+		}
+	}
+	return nil
+}
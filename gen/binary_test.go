@@ -0,0 +1,25 @@
+package gen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Binary", func() {
+	Specify("size per precision", func() {
+		Expect(NewVec3[float32](1.0, 2.0, 3.0).MarshalBinary()).To(HaveLen(12))
+		Expect(NewVec3[float64](1.0, 2.0, 3.0).MarshalBinary()).To(HaveLen(24))
+		Expect(NewVec3[meters](1.0, 2.0, 3.0).MarshalBinary()).To(HaveLen(24))
+	})
+
+	Specify("#UnmarshalBinary", func() {
+		data, err := NewQuat[meters](1.0, 2.0, 3.0, 4.0).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		var quat Quat[meters]
+		Expect(quat.UnmarshalBinary(data)).To(Succeed())
+		Expect(quat).To(Equal(NewQuat[meters](1.0, 2.0, 3.0, 4.0)))
+		Expect(quat.UnmarshalBinary(data[:16])).To(MatchError("invalid Quat binary data: expected 32 bytes but got 16"))
+	})
+})
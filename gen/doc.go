@@ -0,0 +1,11 @@
+// Package gen contains the vector, quaternion and matrix types and functions
+// in a form that is generic over the floating point precision.
+//
+// The sprec and dprec packages are thin wrappers around the float32 and
+// float64 instantiations of this package respectively. Only the core types
+// and their arithmetic live here. Other functionality is written in dprec
+// and the sprec package is generated from it with cmd/precgen.
+//
+// Angles are represented as radians of the same floating point type, since
+// the Angle types of the wrapper packages cannot be expressed generically.
+package gen
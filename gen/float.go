@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"math"
+	"unsafe"
+)
+
+// Float is the constraint that is satisfied by the floating point types
+// that the generic types in this package can be instantiated with.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Epsilon returns the tolerance used for float equality comparisons. It is
+// 1e-6 for single precision and 1e-12 for double precision types.
+func Epsilon[T Float]() T {
+	if bitSize[T]() == 32 {
+		return 0.000001
+	}
+	return 0.000000000001
+}
+
+func bitSize[T Float]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+func eq[T Float](a, b T) bool {
+	return T(math.Abs(float64(a-b))) < Epsilon[T]()
+}
+
+func sqrt[T Float](value T) T {
+	return T(math.Sqrt(float64(value)))
+}
+
+func exp[T Float](value T) T {
+	return T(math.Exp(float64(value)))
+}
+
+func log[T Float](value T) T {
+	return T(math.Log(float64(value)))
+}
+
+func cos[T Float](radians T) T {
+	return T(math.Cos(float64(radians)))
+}
+
+func sin[T Float](radians T) T {
+	return T(math.Sin(float64(radians)))
+}
+
+func tan[T Float](radians T) T {
+	return T(math.Tan(float64(radians)))
+}
+
+func acos[T Float](cs T) T {
+	return T(math.Acos(float64(cs)))
+}
+
+func asin[T Float](sn T) T {
+	return T(math.Asin(float64(sn)))
+}
+
+func atan2[T Float](y, x T) T {
+	return T(math.Atan2(float64(y), float64(x)))
+}
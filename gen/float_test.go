@@ -0,0 +1,18 @@
+package gen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+type meters float64
+
+var _ = Describe("Float", func() {
+	Specify("Epsilon", func() {
+		Expect(Epsilon[float32]()).To(Equal(float32(0.000001)))
+		Expect(Epsilon[float64]()).To(Equal(0.000000000001))
+		Expect(Epsilon[meters]()).To(Equal(meters(0.000000000001)))
+	})
+})
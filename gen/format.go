@@ -0,0 +1,216 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	vec2Labels = []string{"X", "Y"}
+	vec3Labels = []string{"X", "Y", "Z"}
+	vec4Labels = []string{"X", "Y", "Z", "W"}
+	quatLabels = []string{"W", "X", "Y", "Z"}
+	mat2Labels = []string{
+		"M11", "M12",
+		"M21", "M22",
+	}
+	mat3Labels = []string{
+		"M11", "M12", "M13",
+		"M21", "M22", "M23",
+		"M31", "M32", "M33",
+	}
+	mat4Labels = []string{
+		"M11", "M12", "M13", "M14",
+		"M21", "M22", "M23", "M24",
+		"M31", "M32", "M33", "M34",
+		"M41", "M42", "M43", "M44",
+	}
+)
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (v Vec2[T]) Format(state fmt.State, verb rune) {
+	FormatVec2(state, verb, fmt.Sprintf("%T", v), v)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (v Vec3[T]) Format(state fmt.State, verb rune) {
+	FormatVec3(state, verb, fmt.Sprintf("%T", v), v)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (v Vec4[T]) Format(state fmt.State, verb rune) {
+	FormatVec4(state, verb, fmt.Sprintf("%T", v), v)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (q Quat[T]) Format(state fmt.State, verb rune) {
+	FormatQuat(state, verb, fmt.Sprintf("%T", q), q)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (m Mat2[T]) Format(state fmt.State, verb rune) {
+	FormatMat2(state, verb, fmt.Sprintf("%T", m), m)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (m Mat3[T]) Format(state fmt.State, verb rune) {
+	FormatMat3(state, verb, fmt.Sprintf("%T", m), m)
+}
+
+// Format implements fmt.Formatter. See FormatVec2 for details.
+func (m Mat4[T]) Format(state fmt.State, verb rune) {
+	FormatMat4(state, verb, fmt.Sprintf("%T", m), m)
+}
+
+// FormatVec2 writes the vector to state according to the verb, allowing
+// wrapper types to implement fmt.Formatter with their own typeName.
+//
+// The %v, %s, %f, %F, %e, %E, %g and %G verbs are applied to each
+// component, along with the width, precision and the ' ', '-' and '0'
+// flags. The %v and %s verbs behave like %f, which makes %v match the
+// output of String. The '+' flag produces a labelled form (e.g.
+// "(X:1.000000 Y:2.000000)") and %#v produces a Go-syntax form (e.g.
+// "dprec.Vec2{X:1, Y:2}") that uses the shortest exact representation of
+// the components.
+func FormatVec2[T Float](state fmt.State, verb rune, typeName string, v Vec2[T]) {
+	formatComponents(state, verb, typeName, vec2Labels, 0, v.X, v.Y)
+}
+
+// FormatVec3 is like FormatVec2 but for Vec3.
+func FormatVec3[T Float](state fmt.State, verb rune, typeName string, v Vec3[T]) {
+	formatComponents(state, verb, typeName, vec3Labels, 0, v.X, v.Y, v.Z)
+}
+
+// FormatVec4 is like FormatVec2 but for Vec4.
+func FormatVec4[T Float](state fmt.State, verb rune, typeName string, v Vec4[T]) {
+	formatComponents(state, verb, typeName, vec4Labels, 0, v.X, v.Y, v.Z, v.W)
+}
+
+// FormatQuat is like FormatVec2 but for Quat. The components are written
+// in the W, X, Y, Z order of String.
+func FormatQuat[T Float](state fmt.State, verb rune, typeName string, q Quat[T]) {
+	formatComponents(state, verb, typeName, quatLabels, 0, q.W, q.X, q.Y, q.Z)
+}
+
+// FormatMat2 is like FormatVec2 but for Mat2. The components are written
+// as a tuple of rows, like String does.
+func FormatMat2[T Float](state fmt.State, verb rune, typeName string, m Mat2[T]) {
+	values := m.RowMajorArray()
+	formatComponents(state, verb, typeName, mat2Labels, 2, values[:]...)
+}
+
+// FormatMat3 is like FormatVec2 but for Mat3. The components are written
+// as a tuple of rows, like String does.
+func FormatMat3[T Float](state fmt.State, verb rune, typeName string, m Mat3[T]) {
+	values := m.RowMajorArray()
+	formatComponents(state, verb, typeName, mat3Labels, 3, values[:]...)
+}
+
+// FormatMat4 is like FormatVec2 but for Mat4. The components are written
+// as a tuple of rows, like String does.
+func FormatMat4[T Float](state fmt.State, verb rune, typeName string, m Mat4[T]) {
+	values := m.RowMajorArray()
+	formatComponents(state, verb, typeName, mat4Labels, 4, values[:]...)
+}
+
+// formatComponents writes the values as a tuple or, if rowSize is not
+// zero, as a tuple of rows with rowSize elements each.
+func formatComponents[T Float](state fmt.State, verb rune, typeName string, labels []string, rowSize int, values ...T) {
+	switch verb {
+	case 'v', 's', 'f', 'F', 'e', 'E', 'g', 'G':
+	default:
+		fmt.Fprintf(state, "%%!%c(%s=", verb, typeName)
+		writeComponents(state, "%f", nil, rowSize, values)
+		fmt.Fprint(state, ")")
+		return
+	}
+	if verb == 'v' && state.Flag('#') {
+		writeGoSyntax(state, typeName, labels, values)
+		return
+	}
+
+	var format strings.Builder
+	format.WriteByte('%')
+	for _, flag := range " -0#" {
+		if state.Flag(int(flag)) {
+			format.WriteRune(flag)
+		}
+	}
+	if width, ok := state.Width(); ok {
+		format.WriteString(strconv.Itoa(width))
+	}
+	if precision, ok := state.Precision(); ok {
+		format.WriteByte('.')
+		format.WriteString(strconv.Itoa(precision))
+	}
+	if verb == 'v' || verb == 's' {
+		verb = 'f'
+	}
+	format.WriteRune(verb)
+
+	if !state.Flag('+') {
+		labels = nil
+	}
+	writeComponents(state, format.String(), labels, rowSize, values)
+}
+
+func writeComponents[T Float](state fmt.State, format string, labels []string, rowSize int, values []T) {
+	separator := ", "
+	if labels != nil {
+		separator = " "
+	}
+	if rowSize == 0 {
+		rowSize = len(values)
+	} else {
+		fmt.Fprint(state, "(")
+	}
+	for i, value := range values {
+		switch {
+		case i == 0:
+			fmt.Fprint(state, "(")
+		case i%rowSize == 0:
+			fmt.Fprint(state, ")", separator, "(")
+		default:
+			fmt.Fprint(state, separator)
+		}
+		if labels != nil {
+			fmt.Fprint(state, labels[i], ":")
+		}
+		fmt.Fprintf(state, format, value)
+	}
+	fmt.Fprint(state, ")")
+	if rowSize != len(values) {
+		fmt.Fprint(state, ")")
+	}
+}
+
+func writeGoSyntax[T Float](state fmt.State, typeName string, labels []string, values []T) {
+	fmt.Fprint(state, typeName, "{")
+	for i, value := range values {
+		if i > 0 {
+			fmt.Fprint(state, ", ")
+		}
+		fmt.Fprint(state, labels[i], ":", goSyntaxNumber(value))
+	}
+	fmt.Fprint(state, "}")
+}
+
+func goSyntaxNumber[T Float](value T) string {
+	var result string
+	switch {
+	case math.IsNaN(float64(value)):
+		result = "math.NaN()"
+	case math.IsInf(float64(value), 1):
+		result = "math.Inf(1)"
+	case math.IsInf(float64(value), -1):
+		result = "math.Inf(-1)"
+	default:
+		return strconv.FormatFloat(float64(value), 'g', -1, bitSize[T]())
+	}
+	if bitSize[T]() == 32 {
+		return "float32(" + result + ")"
+	}
+	return result
+}
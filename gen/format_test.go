@@ -0,0 +1,31 @@
+package gen_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Format", func() {
+	Specify("#Format", func() {
+		vec := NewVec3[float32](1.0, 2.0, 3.0)
+		Expect(fmt.Sprintf("%v", vec)).To(Equal(vec.String()))
+		Expect(fmt.Sprintf("%#v", vec)).To(Equal("gen.Vec3[float32]{X:1, Y:2, Z:3}"))
+	})
+
+	Specify("FormatVec3", func() {
+		vec := NewVec3[float64](1.0, 2.0, 3.0)
+		Expect(fmt.Sprintf("%#v", formatter(func(state fmt.State, verb rune) {
+			FormatVec3(state, verb, "custom.Vec3", vec)
+		}))).To(Equal("custom.Vec3{X:1, Y:2, Z:3}"))
+	})
+})
+
+type formatter func(state fmt.State, verb rune)
+
+func (f formatter) Format(state fmt.State, verb rune) {
+	f(state, verb)
+}
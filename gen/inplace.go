@@ -0,0 +1,234 @@
+package gen
+
+// Add adds other to the vector in place.
+func (v *Vec2[T]) Add(other Vec2[T]) {
+	v.X += other.X
+	v.Y += other.Y
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec2[T]) Sub(other Vec2[T]) {
+	v.X -= other.X
+	v.Y -= other.Y
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec2[T]) Scale(value T) {
+	v.X *= value
+	v.Y *= value
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec2[T]) AddScaled(other Vec2[T], scale T) {
+	v.X += other.X * scale
+	v.Y += other.Y * scale
+}
+
+// Negate negates the vector in place.
+func (v *Vec2[T]) Negate() {
+	v.X = -v.X
+	v.Y = -v.Y
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec2[T]) Normalize() {
+	length := v.Length()
+	v.X /= length
+	v.Y /= length
+}
+
+// Add adds other to the vector in place.
+func (v *Vec3[T]) Add(other Vec3[T]) {
+	v.X += other.X
+	v.Y += other.Y
+	v.Z += other.Z
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec3[T]) Sub(other Vec3[T]) {
+	v.X -= other.X
+	v.Y -= other.Y
+	v.Z -= other.Z
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec3[T]) Scale(value T) {
+	v.X *= value
+	v.Y *= value
+	v.Z *= value
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec3[T]) AddScaled(other Vec3[T], scale T) {
+	v.X += other.X * scale
+	v.Y += other.Y * scale
+	v.Z += other.Z * scale
+}
+
+// Negate negates the vector in place.
+func (v *Vec3[T]) Negate() {
+	v.X = -v.X
+	v.Y = -v.Y
+	v.Z = -v.Z
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec3[T]) Normalize() {
+	length := v.Length()
+	v.X /= length
+	v.Y /= length
+	v.Z /= length
+}
+
+// Add adds other to the vector in place.
+func (v *Vec4[T]) Add(other Vec4[T]) {
+	v.X += other.X
+	v.Y += other.Y
+	v.Z += other.Z
+	v.W += other.W
+}
+
+// Sub subtracts other from the vector in place.
+func (v *Vec4[T]) Sub(other Vec4[T]) {
+	v.X -= other.X
+	v.Y -= other.Y
+	v.Z -= other.Z
+	v.W -= other.W
+}
+
+// Scale multiplies the vector by value in place.
+func (v *Vec4[T]) Scale(value T) {
+	v.X *= value
+	v.Y *= value
+	v.Z *= value
+	v.W *= value
+}
+
+// AddScaled adds other multiplied by scale to the vector in place.
+func (v *Vec4[T]) AddScaled(other Vec4[T], scale T) {
+	v.X += other.X * scale
+	v.Y += other.Y * scale
+	v.Z += other.Z * scale
+	v.W += other.W * scale
+}
+
+// Negate negates the vector in place.
+func (v *Vec4[T]) Negate() {
+	v.X = -v.X
+	v.Y = -v.Y
+	v.Z = -v.Z
+	v.W = -v.W
+}
+
+// Normalize scales the vector in place to unit length.
+func (v *Vec4[T]) Normalize() {
+	length := v.Length()
+	v.X /= length
+	v.Y /= length
+	v.Z /= length
+	v.W /= length
+}
+
+// Mul sets the quaternion to QuatProd(q, other).
+func (q *Quat[T]) Mul(other Quat[T]) {
+	*q = Quat[T]{
+		W: q.W*other.W - q.X*other.X - q.Y*other.Y - q.Z*other.Z,
+		X: q.W*other.X + q.X*other.W + q.Y*other.Z - q.Z*other.Y,
+		Y: q.W*other.Y - q.X*other.Z + q.Y*other.W + q.Z*other.X,
+		Z: q.W*other.Z + q.X*other.Y - q.Y*other.X + q.Z*other.W,
+	}
+}
+
+// Conjugate conjugates the quaternion in place.
+func (q *Quat[T]) Conjugate() {
+	q.X = -q.X
+	q.Y = -q.Y
+	q.Z = -q.Z
+}
+
+// Normalize scales the quaternion in place to unit norm.
+func (q *Quat[T]) Normalize() {
+	norm := q.Norm()
+	q.W /= norm
+	q.X /= norm
+	q.Y /= norm
+	q.Z /= norm
+}
+
+// Mul sets the matrix to Mat2Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat2[T]) Mul(other *Mat2[T]) {
+	*m = Mat2[T]{
+		M11: m.M11*other.M11 + m.M12*other.M21,
+		M12: m.M11*other.M12 + m.M12*other.M22,
+
+		M21: m.M21*other.M11 + m.M22*other.M21,
+		M22: m.M21*other.M12 + m.M22*other.M22,
+	}
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat2[T]) Transpose() {
+	m.M12, m.M21 = m.M21, m.M12
+}
+
+// Mul sets the matrix to Mat3Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat3[T]) Mul(other *Mat3[T]) {
+	*m = Mat3[T]{
+		M11: m.M11*other.M11 + m.M12*other.M21 + m.M13*other.M31,
+		M12: m.M11*other.M12 + m.M12*other.M22 + m.M13*other.M32,
+		M13: m.M11*other.M13 + m.M12*other.M23 + m.M13*other.M33,
+
+		M21: m.M21*other.M11 + m.M22*other.M21 + m.M23*other.M31,
+		M22: m.M21*other.M12 + m.M22*other.M22 + m.M23*other.M32,
+		M23: m.M21*other.M13 + m.M22*other.M23 + m.M23*other.M33,
+
+		M31: m.M31*other.M11 + m.M32*other.M21 + m.M33*other.M31,
+		M32: m.M31*other.M12 + m.M32*other.M22 + m.M33*other.M32,
+		M33: m.M31*other.M13 + m.M32*other.M23 + m.M33*other.M33,
+	}
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat3[T]) Transpose() {
+	m.M12, m.M21 = m.M21, m.M12
+	m.M13, m.M31 = m.M31, m.M13
+	m.M23, m.M32 = m.M32, m.M23
+}
+
+// Mul sets the matrix to Mat4Prod(m, other). The other matrix is passed
+// by pointer to avoid copying and may be the same as m.
+func (m *Mat4[T]) Mul(other *Mat4[T]) {
+	*m = Mat4[T]{
+		M11: m.M11*other.M11 + m.M12*other.M21 + m.M13*other.M31 + m.M14*other.M41,
+		M12: m.M11*other.M12 + m.M12*other.M22 + m.M13*other.M32 + m.M14*other.M42,
+		M13: m.M11*other.M13 + m.M12*other.M23 + m.M13*other.M33 + m.M14*other.M43,
+		M14: m.M11*other.M14 + m.M12*other.M24 + m.M13*other.M34 + m.M14*other.M44,
+
+		M21: m.M21*other.M11 + m.M22*other.M21 + m.M23*other.M31 + m.M24*other.M41,
+		M22: m.M21*other.M12 + m.M22*other.M22 + m.M23*other.M32 + m.M24*other.M42,
+		M23: m.M21*other.M13 + m.M22*other.M23 + m.M23*other.M33 + m.M24*other.M43,
+		M24: m.M21*other.M14 + m.M22*other.M24 + m.M23*other.M34 + m.M24*other.M44,
+
+		M31: m.M31*other.M11 + m.M32*other.M21 + m.M33*other.M31 + m.M34*other.M41,
+		M32: m.M31*other.M12 + m.M32*other.M22 + m.M33*other.M32 + m.M34*other.M42,
+		M33: m.M31*other.M13 + m.M32*other.M23 + m.M33*other.M33 + m.M34*other.M43,
+		M34: m.M31*other.M14 + m.M32*other.M24 + m.M33*other.M34 + m.M34*other.M44,
+
+		M41: m.M41*other.M11 + m.M42*other.M21 + m.M43*other.M31 + m.M44*other.M41,
+		M42: m.M41*other.M12 + m.M42*other.M22 + m.M43*other.M32 + m.M44*other.M42,
+		M43: m.M41*other.M13 + m.M42*other.M23 + m.M43*other.M33 + m.M44*other.M43,
+		M44: m.M41*other.M14 + m.M42*other.M24 + m.M43*other.M34 + m.M44*other.M44,
+	}
+}
+
+// Transpose transposes the matrix in place.
+func (m *Mat4[T]) Transpose() {
+	m.M12, m.M21 = m.M21, m.M12
+	m.M13, m.M31 = m.M31, m.M13
+	m.M14, m.M41 = m.M41, m.M14
+	m.M23, m.M32 = m.M32, m.M23
+	m.M24, m.M42 = m.M42, m.M24
+	m.M34, m.M43 = m.M43, m.M34
+}
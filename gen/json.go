@@ -0,0 +1,205 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y].
+func (v Vec2[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray("Vec2", v.X, v.Y)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0} is accepted as well.
+func (v *Vec2[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Vec2", []*T{&v.X, &v.Y}, (*jsonVec2[T])(v))
+}
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y, z].
+func (v Vec3[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray("Vec3", v.X, v.Y, v.Z)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0, "z": 0} is accepted as well.
+func (v *Vec3[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Vec3", []*T{&v.X, &v.Y, &v.Z}, (*jsonVec3[T])(v))
+}
+
+// MarshalJSON implements json.Marshaler. The vector is encoded as the
+// array [x, y, z, w].
+func (v Vec4[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray("Vec4", v.X, v.Y, v.Z, v.W)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"x": 0, "y": 0, "z": 0, "w": 0} is accepted as well.
+func (v *Vec4[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Vec4", []*T{&v.X, &v.Y, &v.Z, &v.W}, (*jsonVec4[T])(v))
+}
+
+// MarshalJSON implements json.Marshaler. The quaternion is encoded as the
+// array [w, x, y, z], following the argument order of NewQuat.
+func (q Quat[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray("Quat", q.W, q.X, q.Y, q.Z)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the array form,
+// the object form {"w": 1, "x": 0, "y": 0, "z": 0} is accepted as well.
+func (q *Quat[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Quat", []*T{&q.W, &q.X, &q.Y, &q.Z}, (*jsonQuat[T])(q))
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of four elements in column-major order, matching ColumnMajorArray.
+func (m Mat2[T]) MarshalJSON() ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return marshalJSONArray("Mat2", values[:]...)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat2[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Mat2", []*T{
+		&m.M11, &m.M21,
+		&m.M12, &m.M22,
+	}, (*jsonMat2[T])(m))
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of nine elements in column-major order, matching ColumnMajorArray.
+func (m Mat3[T]) MarshalJSON() ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return marshalJSONArray("Mat3", values[:]...)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat3[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Mat3", []*T{
+		&m.M11, &m.M21, &m.M31,
+		&m.M12, &m.M22, &m.M32,
+		&m.M13, &m.M23, &m.M33,
+	}, (*jsonMat3[T])(m))
+}
+
+// MarshalJSON implements json.Marshaler. The matrix is encoded as an array
+// of sixteen elements in column-major order, matching ColumnMajorArray.
+func (m Mat4[T]) MarshalJSON() ([]byte, error) {
+	values := m.ColumnMajorArray()
+	return marshalJSONArray("Mat4", values[:]...)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Apart from the column-major
+// array form, the object form {"m11": 1, "m12": 0, ...} is accepted as
+// well.
+func (m *Mat4[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, "Mat4", []*T{
+		&m.M11, &m.M21, &m.M31, &m.M41,
+		&m.M12, &m.M22, &m.M32, &m.M42,
+		&m.M13, &m.M23, &m.M33, &m.M43,
+		&m.M14, &m.M24, &m.M34, &m.M44,
+	}, (*jsonMat4[T])(m))
+}
+
+// MarshalAngleJSON returns the JSON encoding of an angle, which is the
+// number of radians.
+func MarshalAngleJSON[T Float](radians T) ([]byte, error) {
+	return appendJSONNumber(nil, "Angle", radians)
+}
+
+// UnmarshalAngleJSON parses the JSON encoding of an angle and stores the
+// number of radians in target. Apart from a plain number of radians, a
+// string with a "deg" or "rad" unit suffix (e.g. "90deg") is accepted as
+// well.
+func UnmarshalAngleJSON[T Float](data []byte, target *T) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '"' {
+		if err := json.Unmarshal(data, target); err != nil {
+			return fmt.Errorf("invalid Angle JSON: %w", err)
+		}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("invalid Angle JSON: %w", err)
+	}
+	radians, err := parseAngle[T](text, true)
+	if err != nil {
+		return fmt.Errorf("invalid Angle JSON: %w", err)
+	}
+	*target = radians
+	return nil
+}
+
+// The following types have the same fields as the public ones but none of
+// the methods, which allows them to be decoded from the object form without
+// recursing into UnmarshalJSON.
+type (
+	jsonVec2[T Float] Vec2[T]
+	jsonVec3[T Float] Vec3[T]
+	jsonVec4[T Float] Vec4[T]
+	jsonQuat[T Float] Quat[T]
+	jsonMat2[T Float] Mat2[T]
+	jsonMat3[T Float] Mat3[T]
+	jsonMat4[T Float] Mat4[T]
+)
+
+func marshalJSONArray[T Float](typeName string, values ...T) ([]byte, error) {
+	result := make([]byte, 0, len(values)*8)
+	result = append(result, '[')
+	for i, value := range values {
+		if i > 0 {
+			result = append(result, ',')
+		}
+		var err error
+		if result, err = appendJSONNumber(result, typeName, value); err != nil {
+			return nil, err
+		}
+	}
+	return append(result, ']'), nil
+}
+
+func appendJSONNumber[T Float](data []byte, typeName string, value T) ([]byte, error) {
+	if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+		return nil, fmt.Errorf("cannot encode %s with value %v as JSON", typeName, value)
+	}
+	return strconv.AppendFloat(data, float64(value), 'g', -1, bitSize[T]()), nil
+}
+
+func unmarshalJSON[T Float](data []byte, typeName string, elements []*T, object any) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) > 0 && data[0] == '[':
+		var values []T
+		if err := json.Unmarshal(data, &values); err != nil {
+			return fmt.Errorf("invalid %s JSON: %w", typeName, err)
+		}
+		if len(values) != len(elements) {
+			return fmt.Errorf("invalid %s JSON: expected %d array elements but got %d", typeName, len(elements), len(values))
+		}
+		for i, value := range values {
+			*elements[i] = value
+		}
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(object); err != nil {
+			return fmt.Errorf("invalid %s JSON: %w", typeName, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid %s JSON: expected array or object but got %q", typeName, data)
+	}
+}
@@ -0,0 +1,45 @@
+package gen_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("JSON", func() {
+	Specify("shortest representation per precision", func() {
+		Expect(json.Marshal(NewVec2[float32](0.1, 0.2))).To(MatchJSON(`[0.1,0.2]`))
+		Expect(json.Marshal(NewVec2[float64](0.1, 0.2))).To(MatchJSON(`[0.1,0.2]`))
+		Expect(json.Marshal(NewVec2[meters](1.5, 2.0))).To(MatchJSON(`[1.5,2]`))
+	})
+
+	Specify("non-finite values", func() {
+		_, err := json.Marshal(NewVec3(1.0, math.NaN(), 3.0))
+		Expect(err).To(HaveOccurred())
+		_, err = MarshalAngleJSON(math.Inf(1))
+		Expect(err).To(HaveOccurred())
+	})
+
+	Specify("UnmarshalAngleJSON", func() {
+		var radians float32
+		Expect(UnmarshalAngleJSON([]byte(`"180deg"`), &radians)).To(Succeed())
+		Expect(radians).To(BeNumerically("~", math.Pi, 0.000001))
+		Expect(UnmarshalAngleJSON([]byte(`"0.5rad"`), &radians)).To(Succeed())
+		Expect(radians).To(Equal(float32(0.5)))
+		Expect(UnmarshalAngleJSON([]byte(`1.25`), &radians)).To(Succeed())
+		Expect(radians).To(Equal(float32(1.25)))
+		Expect(UnmarshalAngleJSON([]byte(`"90"`), &radians)).ToNot(Succeed())
+		Expect(UnmarshalAngleJSON([]byte(`"abcdeg"`), &radians)).ToNot(Succeed())
+	})
+
+	Specify("#UnmarshalJSON", func() {
+		var vec Vec4[meters]
+		Expect(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3,"w":4}`), &vec)).To(Succeed())
+		Expect(vec).To(Equal(NewVec4[meters](1.0, 2.0, 3.0, 4.0)))
+		Expect(json.Unmarshal([]byte(`[1,2,3]`), &vec)).ToNot(Succeed())
+	})
+})
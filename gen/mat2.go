@@ -0,0 +1,181 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewMat2 creates a Mat2 from the given row-major element values.
+func NewMat2[T Float](
+	m11, m12 T,
+	m21, m22 T,
+) Mat2[T] {
+	return Mat2[T]{
+		M11: m11, M12: m12,
+		M21: m21, M22: m22,
+	}
+}
+
+// ZeroMat2 returns a zero Mat2.
+func ZeroMat2[T Float]() Mat2[T] {
+	return Mat2[T]{}
+}
+
+// IdentityMat2 returns the identity Mat2.
+func IdentityMat2[T Float]() Mat2[T] {
+	var result Mat2[T]
+	result.M11 = 1.0
+	result.M22 = 1.0
+	return result
+}
+
+// TransposedMat2 returns the transpose of the given matrix.
+func TransposedMat2[T Float](m Mat2[T]) Mat2[T] {
+	return NewMat2(
+		m.M11, m.M21,
+		m.M12, m.M22,
+	)
+}
+
+// ScaleMat2 returns a 2D scale matrix for the given scale factors.
+func ScaleMat2[T Float](x, y T) Mat2[T] {
+	var result Mat2[T]
+	result.M11 = x
+	result.M22 = y
+	return result
+}
+
+// RotationMat2 returns a 2D rotation matrix for the given angle in
+// radians.
+func RotationMat2[T Float](angle T) Mat2[T] {
+	cs := cos(angle)
+	sn := sin(angle)
+	return NewMat2(
+		cs, -sn,
+		sn, cs,
+	)
+}
+
+// InverseMat2 calculates the inverse of the matrix.
+//
+// The behavior is undefined if the matrix is not reversible
+// (i.e. has a zero determinant).
+func InverseMat2[T Float](m Mat2[T]) Mat2[T] {
+	determinant := m.Determinant()
+	return NewMat2(
+		m.M22/determinant, -m.M12/determinant,
+		-m.M21/determinant, m.M11/determinant,
+	)
+}
+
+// RowMajorArrayToMat2 creates a Mat2 from a row-major array.
+func RowMajorArrayToMat2[T Float](values [4]T) Mat2[T] {
+	return Mat2[T]{
+		M11: values[0], M12: values[1],
+		M21: values[2], M22: values[3],
+	}
+}
+
+// ColumnMajorArrayToMat2 creates a Mat2 from a column-major array.
+func ColumnMajorArrayToMat2[T Float](values [4]T) Mat2[T] {
+	return Mat2[T]{
+		M11: values[0], M12: values[2],
+		M21: values[1], M22: values[3],
+	}
+}
+
+// Mat2Prod returns the product of two matrices.
+func Mat2Prod[T Float](left, right Mat2[T]) Mat2[T] {
+	return Mat2[T]{
+		M11: left.M11*right.M11 + left.M12*right.M21,
+		M12: left.M11*right.M12 + left.M12*right.M22,
+
+		M21: left.M21*right.M11 + left.M22*right.M21,
+		M22: left.M21*right.M12 + left.M22*right.M22,
+	}
+}
+
+// Mat2MultiProd returns the product of multiple matrices.
+func Mat2MultiProd[T Float](first Mat2[T], others ...Mat2[T]) Mat2[T] {
+	result := first
+	for _, matrix := range others {
+		result = Mat2Prod(result, matrix)
+	}
+	return result
+}
+
+// Mat2Vec2Prod multiplies a matrix by a Vec2.
+func Mat2Vec2Prod[T Float](mat Mat2[T], vec Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: mat.M11*vec.X + mat.M12*vec.Y,
+		Y: mat.M21*vec.X + mat.M22*vec.Y,
+	}
+}
+
+// Mat2 is a 2x2 matrix with components of type T.
+// Fields use row-major notation: M<row><col>.
+type Mat2[T Float] struct {
+	M11, M12 T
+	M21, M22 T
+}
+
+// IsNaN returns true if any component is NaN.
+func (m Mat2[T]) IsNaN() bool {
+	return math.IsNaN(float64(m.M11)) || math.IsNaN(float64(m.M12)) ||
+		math.IsNaN(float64(m.M21)) || math.IsNaN(float64(m.M22))
+}
+
+// IsInf returns true if any component is Inf.
+func (m Mat2[T]) IsInf() bool {
+	return math.IsInf(float64(m.M11), 0) || math.IsInf(float64(m.M12), 0) ||
+		math.IsInf(float64(m.M21), 0) || math.IsInf(float64(m.M22), 0)
+}
+
+// Row1 returns the first row as a Vec2.
+func (m Mat2[T]) Row1() Vec2[T] {
+	return NewVec2(m.M11, m.M12)
+}
+
+// Row2 returns the second row as a Vec2.
+func (m Mat2[T]) Row2() Vec2[T] {
+	return NewVec2(m.M21, m.M22)
+}
+
+// Column1 returns the first column as a Vec2.
+func (m Mat2[T]) Column1() Vec2[T] {
+	return NewVec2(m.M11, m.M21)
+}
+
+// Column2 returns the second column as a Vec2.
+func (m Mat2[T]) Column2() Vec2[T] {
+	return NewVec2(m.M12, m.M22)
+}
+
+// Determinant returns the determinant of the matrix.
+func (m Mat2[T]) Determinant() T {
+	return m.M11*m.M22 - m.M12*m.M21
+}
+
+// RowMajorArray returns the matrix components in row-major order.
+func (m Mat2[T]) RowMajorArray() [4]T {
+	return [4]T{
+		m.M11, m.M12,
+		m.M21, m.M22,
+	}
+}
+
+// ColumnMajorArray returns the matrix components in column-major order.
+func (m Mat2[T]) ColumnMajorArray() [4]T {
+	return [4]T{
+		m.M11, m.M21,
+		m.M12, m.M22,
+	}
+}
+
+// String returns a string representation of the matrix.
+func (m Mat2[T]) String() string {
+	return fmt.Sprintf("((%f, %f), (%f, %f))",
+		m.M11, m.M12,
+		m.M21, m.M22,
+	)
+}
@@ -0,0 +1,430 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewMat3 creates a Mat3 from the given row-major element values.
+func NewMat3[T Float](
+	m11, m12, m13 T,
+	m21, m22, m23 T,
+	m31, m32, m33 T,
+) Mat3[T] {
+	return Mat3[T]{
+		M11: m11, M12: m12, M13: m13,
+		M21: m21, M22: m22, M23: m23,
+		M31: m31, M32: m32, M33: m33,
+	}
+}
+
+// ZeroMat3 returns a zero Mat3.
+func ZeroMat3[T Float]() Mat3[T] {
+	return Mat3[T]{}
+}
+
+// IdentityMat3 returns the identity Mat3.
+func IdentityMat3[T Float]() Mat3[T] {
+	var result Mat3[T]
+	result.M11 = 1.0
+	result.M22 = 1.0
+	result.M33 = 1.0
+	return result
+}
+
+// TransposedMat3 returns the transpose of the given matrix.
+func TransposedMat3[T Float](m Mat3[T]) Mat3[T] {
+	return NewMat3(
+		m.M11, m.M21, m.M31,
+		m.M12, m.M22, m.M32,
+		m.M13, m.M23, m.M33,
+	)
+}
+
+// TranslationMat3 returns a 2D translation matrix for the given offsets.
+func TranslationMat3[T Float](x, y T) Mat3[T] {
+	result := IdentityMat3[T]()
+	result.M13 = x
+	result.M23 = y
+	return result
+}
+
+// ScaleMat3 returns a 2D scale matrix for the given scale factors.
+func ScaleMat3[T Float](x, y T) Mat3[T] {
+	var result Mat3[T]
+	result.M11 = x
+	result.M22 = y
+	result.M33 = 1.0
+	return result
+}
+
+// RotationMat3 returns a 2D rotation matrix for the given angle in
+// radians.
+func RotationMat3[T Float](angle T) Mat3[T] {
+	cs := cos(angle)
+	sn := sin(angle)
+
+	var result Mat3[T]
+	result.M11 = cs
+	result.M12 = -sn
+	result.M21 = sn
+	result.M22 = cs
+	result.M33 = 1.0
+	return result
+}
+
+// DiagonalMat3 returns a 3D linear scale matrix for the given scale
+// factors, which has them as its diagonal elements.
+func DiagonalMat3[T Float](x, y, z T) Mat3[T] {
+	var result Mat3[T]
+	result.M11 = x
+	result.M22 = y
+	result.M33 = z
+	return result
+}
+
+// AxisAngleMat3 returns a 3D linear rotation matrix for the given angle in
+// radians around the axis direction.
+func AxisAngleMat3[T Float](angle T, axis Vec3[T]) Mat3[T] {
+	rotation := rotationMat4FromNormalizedData(cos(angle), sin(angle), UnitVec3(axis))
+	return NewMat3(
+		rotation.M11, rotation.M12, rotation.M13,
+		rotation.M21, rotation.M22, rotation.M23,
+		rotation.M31, rotation.M32, rotation.M33,
+	)
+}
+
+// QuatToMat3 returns the 3D linear rotation matrix that is equivalent to
+// the specified quaternion.
+func QuatToMat3[T Float](q Quat[T]) Mat3[T] {
+	return OrientationMat3(q.OrientationX(), q.OrientationY(), q.OrientationZ())
+}
+
+// OrientationMat3 builds a 3D linear orientation matrix from three axis
+// vectors, which become its columns.
+func OrientationMat3[T Float](orientX, orientY, orientZ Vec3[T]) Mat3[T] {
+	return NewMat3(
+		orientX.X, orientY.X, orientZ.X,
+		orientX.Y, orientY.Y, orientZ.Y,
+		orientX.Z, orientY.Z, orientZ.Z,
+	)
+}
+
+// NormalMat3 returns the matrix that transforms surface normals for the
+// specified transformation matrix. This is the inverse transpose of the
+// upper-left 3x3 part of the matrix, which keeps normals perpendicular to
+// surfaces even with non-uniform scale. The result is not normalized.
+//
+// The behavior is undefined if the matrix is not reversible.
+func NormalMat3[T Float](m Mat4[T]) Mat3[T] {
+	return TransposedMat3(InverseMat3(NewMat3(
+		m.M11, m.M12, m.M13,
+		m.M21, m.M22, m.M23,
+		m.M31, m.M32, m.M33,
+	)))
+}
+
+// Vec3OuterProd returns the outer product of two vectors, which is the
+// matrix with elements a[row] * b[column].
+func Vec3OuterProd[T Float](a, b Vec3[T]) Mat3[T] {
+	return NewMat3(
+		a.X*b.X, a.X*b.Y, a.X*b.Z,
+		a.Y*b.X, a.Y*b.Y, a.Y*b.Z,
+		a.Z*b.X, a.Z*b.Y, a.Z*b.Z,
+	)
+}
+
+// OrthoMat3 returns a 2D orthographic projection matrix.
+func OrthoMat3[T Float](left, right, top, bottom T) Mat3[T] {
+	var result Mat3[T]
+	result.M11 = 2.0 / (right - left)
+	result.M13 = (right + left) / (left - right)
+
+	result.M22 = 2.0 / (top - bottom)
+	result.M23 = (top + bottom) / (bottom - top)
+
+	result.M33 = 1.0
+	return result
+}
+
+// FastInverseMat3 calculates the inverse of the matrix with a few caveats.
+//
+// The matrix should be a transformation one that was constructed through the multiplication
+// of one or more of the following transformations: identity, translation, rotation.
+//
+// For all other scenarios (e.g. a scale transformation was used), the InverseMat3 method should be
+// used instead, though it will be slower.
+func FastInverseMat3[T Float](m Mat3[T]) Mat3[T] {
+	inverseTranslate := TranslationMat3(-m.M13, -m.M23)
+	inverseRotate := NewMat3(
+		m.M11, m.M21, 0.0,
+		m.M12, m.M22, 0.0,
+		0.0, 0.0, 1.0,
+	)
+	return Mat3Prod(inverseRotate, inverseTranslate)
+}
+
+// InverseMat3 calculates the inverse of the matrix.
+//
+// The behavior is undefined if the matrix is not reversible
+// (i.e. has a zero determinant).
+func InverseMat3[T Float](m Mat3[T]) Mat3[T] {
+	minor11 := m.M22*m.M33 - m.M23*m.M32
+	minor12 := m.M21*m.M33 - m.M23*m.M31
+	minor13 := m.M21*m.M32 - m.M22*m.M31
+
+	minor21 := m.M12*m.M33 - m.M13*m.M32
+	minor22 := m.M11*m.M33 - m.M13*m.M31
+	minor23 := m.M11*m.M32 - m.M12*m.M31
+
+	minor31 := m.M12*m.M23 - m.M13*m.M22
+	minor32 := m.M11*m.M23 - m.M13*m.M21
+	minor33 := m.M11*m.M22 - m.M12*m.M21
+
+	determinant := m.M11*minor11 - m.M12*minor12 + m.M13*minor13
+
+	return NewMat3(
+		+minor11/determinant, -minor21/determinant, +minor31/determinant,
+		-minor12/determinant, +minor22/determinant, -minor32/determinant,
+		+minor13/determinant, -minor23/determinant, +minor33/determinant,
+	)
+}
+
+// AdjugateMat3 returns the adjugate (the transpose of the cofactor matrix)
+// of the given matrix. Unlike the inverse, it is defined for singular
+// matrices as well.
+func AdjugateMat3[T Float](m Mat3[T]) Mat3[T] {
+	return NewMat3(
+		m.M22*m.M33-m.M23*m.M32, m.M13*m.M32-m.M12*m.M33, m.M12*m.M23-m.M13*m.M22,
+		m.M23*m.M31-m.M21*m.M33, m.M11*m.M33-m.M13*m.M31, m.M13*m.M21-m.M11*m.M23,
+		m.M21*m.M32-m.M22*m.M31, m.M12*m.M31-m.M11*m.M32, m.M11*m.M22-m.M12*m.M21,
+	)
+}
+
+// TransformationMat3 builds a 2D transformation matrix from orientation
+// vectors and a translation.
+func TransformationMat3[T Float](orientX, orientY, translation Vec2[T]) Mat3[T] {
+	var result Mat3[T]
+	result.M11 = orientX.X
+	result.M12 = orientY.X
+	result.M13 = translation.X
+
+	result.M21 = orientX.Y
+	result.M22 = orientY.Y
+	result.M23 = translation.Y
+
+	result.M33 = 1.0
+	return result
+}
+
+// RowMajorArrayToMat3 creates a Mat3 from a row-major array.
+func RowMajorArrayToMat3[T Float](values [9]T) Mat3[T] {
+	return Mat3[T]{
+		M11: values[0], M12: values[1], M13: values[2],
+		M21: values[3], M22: values[4], M23: values[5],
+		M31: values[6], M32: values[7], M33: values[8],
+	}
+}
+
+// ColumnMajorArrayToMat3 creates a Mat3 from a column-major array.
+func ColumnMajorArrayToMat3[T Float](values [9]T) Mat3[T] {
+	return Mat3[T]{
+		M11: values[0], M12: values[3], M13: values[6],
+		M21: values[1], M22: values[4], M23: values[7],
+		M31: values[2], M32: values[5], M33: values[8],
+	}
+}
+
+// Mat3Prod returns the product of two matrices.
+func Mat3Prod[T Float](left, right Mat3[T]) Mat3[T] {
+	return Mat3[T]{
+		M11: left.M11*right.M11 + left.M12*right.M21 + left.M13*right.M31,
+		M12: left.M11*right.M12 + left.M12*right.M22 + left.M13*right.M32,
+		M13: left.M11*right.M13 + left.M12*right.M23 + left.M13*right.M33,
+
+		M21: left.M21*right.M11 + left.M22*right.M21 + left.M23*right.M31,
+		M22: left.M21*right.M12 + left.M22*right.M22 + left.M23*right.M32,
+		M23: left.M21*right.M13 + left.M22*right.M23 + left.M23*right.M33,
+
+		M31: left.M31*right.M11 + left.M32*right.M21 + left.M33*right.M31,
+		M32: left.M31*right.M12 + left.M32*right.M22 + left.M33*right.M32,
+		M33: left.M31*right.M13 + left.M32*right.M23 + left.M33*right.M33,
+	}
+}
+
+// Mat3MultiProd returns the product of multiple matrices.
+func Mat3MultiProd[T Float](first Mat3[T], others ...Mat3[T]) Mat3[T] {
+	result := first
+	for _, matrix := range others {
+		result = Mat3Prod(result, matrix)
+	}
+	return result
+}
+
+// Mat3Vec3Prod multiplies a matrix by a Vec3.
+func Mat3Vec3Prod[T Float](mat Mat3[T], vec Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13*vec.Z,
+		Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23*vec.Z,
+		Z: mat.M31*vec.X + mat.M32*vec.Y + mat.M33*vec.Z,
+	}
+}
+
+// Mat3Vec2Transformation applies the 2D affine transformation to a Vec2.
+func Mat3Vec2Transformation[T Float](mat Mat3[T], vec Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: mat.M11*vec.X + mat.M12*vec.Y + mat.M13,
+		Y: mat.M21*vec.X + mat.M22*vec.Y + mat.M23,
+	}
+}
+
+// Mat3 is a 3x3 matrix with components of type T.
+// Fields use row-major notation: M<row><col>.
+//
+// It is used both as a 2D affine transformation (e.g. TranslationMat3,
+// RotationMat3) and as a 3D linear transformation (e.g. AxisAngleMat3,
+// QuatToMat3).
+type Mat3[T Float] struct {
+	M11, M12, M13 T
+	M21, M22, M23 T
+	M31, M32, M33 T
+}
+
+// IsNaN returns true if any component is NaN.
+func (m Mat3[T]) IsNaN() bool {
+	return math.IsNaN(float64(m.M11)) || math.IsNaN(float64(m.M12)) || math.IsNaN(float64(m.M13)) ||
+		math.IsNaN(float64(m.M21)) || math.IsNaN(float64(m.M22)) || math.IsNaN(float64(m.M23)) ||
+		math.IsNaN(float64(m.M31)) || math.IsNaN(float64(m.M32)) || math.IsNaN(float64(m.M33))
+}
+
+// IsInf returns true if any component is Inf.
+func (m Mat3[T]) IsInf() bool {
+	return math.IsInf(float64(m.M11), 0) || math.IsInf(float64(m.M12), 0) || math.IsInf(float64(m.M13), 0) ||
+		math.IsInf(float64(m.M21), 0) || math.IsInf(float64(m.M22), 0) || math.IsInf(float64(m.M23), 0) ||
+		math.IsInf(float64(m.M31), 0) || math.IsInf(float64(m.M32), 0) || math.IsInf(float64(m.M33), 0)
+}
+
+// Row1 returns the first row as a Vec3.
+func (m Mat3[T]) Row1() Vec3[T] {
+	return NewVec3(m.M11, m.M12, m.M13)
+}
+
+// Row2 returns the second row as a Vec3.
+func (m Mat3[T]) Row2() Vec3[T] {
+	return NewVec3(m.M21, m.M22, m.M23)
+}
+
+// Row3 returns the third row as a Vec3.
+func (m Mat3[T]) Row3() Vec3[T] {
+	return NewVec3(m.M31, m.M32, m.M33)
+}
+
+// Column1 returns the first column as a Vec3.
+func (m Mat3[T]) Column1() Vec3[T] {
+	return NewVec3(m.M11, m.M21, m.M31)
+}
+
+// Column2 returns the second column as a Vec3.
+func (m Mat3[T]) Column2() Vec3[T] {
+	return NewVec3(m.M12, m.M22, m.M32)
+}
+
+// Column3 returns the third column as a Vec3.
+func (m Mat3[T]) Column3() Vec3[T] {
+	return NewVec3(m.M13, m.M23, m.M33)
+}
+
+// OrientationX returns the X orientation vector of the matrix.
+func (m Mat3[T]) OrientationX() Vec2[T] {
+	return NewVec2(m.M11, m.M21)
+}
+
+// OrientationY returns the Y orientation vector of the matrix.
+func (m Mat3[T]) OrientationY() Vec2[T] {
+	return NewVec2(m.M12, m.M22)
+}
+
+// Translation returns the translation vector of the matrix.
+func (m Mat3[T]) Translation() Vec2[T] {
+	return NewVec2(m.M13, m.M23)
+}
+
+// Determinant returns the determinant of the matrix.
+func (m Mat3[T]) Determinant() T {
+	return m.M11*(m.M22*m.M33-m.M23*m.M32) -
+		m.M12*(m.M21*m.M33-m.M23*m.M31) +
+		m.M13*(m.M21*m.M32-m.M22*m.M31)
+}
+
+// Rotation returns the rotation that is represented by this 3D linear
+// matrix.
+//
+// NOTE: This function assumes that the matrix is orthonormal (i.e. has
+// identity scale).
+func (m Mat3[T]) Rotation() Quat[T] {
+	// This is the Shepperd method. The four squared quaternion components
+	// are derived by inversing the equations for quat.OrientationX,
+	// quat.OrientationY and quat.OrientationZ.
+
+	const invFour = 1.0 / 4.0
+	sqrW := (1.0 + m.M11 + m.M22 + m.M33) * invFour
+	sqrX := (1.0 + m.M11 - m.M22 - m.M33) * invFour
+	sqrY := (1.0 - m.M11 + m.M22 - m.M33) * invFour
+	sqrZ := (1.0 - m.M11 - m.M22 + m.M33) * invFour
+
+	var x, y, z, w T
+	switch {
+	case sqrW >= sqrX && sqrW >= sqrY && sqrW >= sqrZ: // W is largest
+		w = sqrt(sqrW)
+		scale := 1.0 / (4.0 * w)
+		x = (m.M32 - m.M23) * scale
+		y = (m.M13 - m.M31) * scale
+		z = (m.M21 - m.M12) * scale
+	case sqrZ >= sqrX && sqrZ >= sqrY: // Z is largest
+		z = sqrt(sqrZ)
+		scale := 1.0 / (4.0 * z)
+		x = (m.M31 + m.M13) * scale
+		y = (m.M32 + m.M23) * scale
+		w = (m.M21 - m.M12) * scale
+	case sqrY >= sqrX: // Y is largest
+		y = sqrt(sqrY)
+		scale := 1.0 / (4.0 * y)
+		x = (m.M21 + m.M12) * scale
+		z = (m.M32 + m.M23) * scale
+		w = (m.M13 - m.M31) * scale
+	default: // X is largest
+		x = sqrt(sqrX)
+		scale := 1.0 / (4.0 * x)
+		y = (m.M21 + m.M12) * scale
+		z = (m.M31 + m.M13) * scale
+		w = (m.M32 - m.M23) * scale
+	}
+	return UnitQuat(NewQuat(w, x, y, z))
+}
+
+// RowMajorArray returns the matrix components in row-major order.
+func (m Mat3[T]) RowMajorArray() [9]T {
+	return [9]T{
+		m.M11, m.M12, m.M13,
+		m.M21, m.M22, m.M23,
+		m.M31, m.M32, m.M33,
+	}
+}
+
+// ColumnMajorArray returns the matrix components in column-major order.
+func (m Mat3[T]) ColumnMajorArray() [9]T {
+	return [9]T{
+		m.M11, m.M21, m.M31,
+		m.M12, m.M22, m.M32,
+		m.M13, m.M23, m.M33,
+	}
+}
+
+// String returns a string representation of the matrix.
+func (m Mat3[T]) String() string {
+	return fmt.Sprintf("((%f, %f, %f), (%f, %f, %f), (%f, %f, %f))",
+		m.M11, m.M12, m.M13,
+		m.M21, m.M22, m.M23,
+		m.M31, m.M32, m.M33,
+	)
+}
@@ -0,0 +1,24 @@
+package gen_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Mat3", func() {
+	Specify("RotationMat3", func() {
+		matrix := RotationMat3(math.Pi / 2.0)
+		vector := Mat3Vec2Transformation(matrix, BasisXVec2[float64]())
+		Expect(vector.X).To(BeNumerically("~", 0.0, 0.000000000001))
+		Expect(vector.Y).To(BeNumerically("~", 1.0, 0.000000000001))
+	})
+
+	Specify("InverseMat3", func() {
+		matrix := Mat3Prod(TranslationMat3[float32](2.0, 3.0), ScaleMat3[float32](2.0, 4.0))
+		Expect(Mat3Prod(matrix, InverseMat3(matrix))).To(Equal(IdentityMat3[float32]()))
+	})
+})
@@ -0,0 +1,32 @@
+package gen_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Mat4", func() {
+	Specify("Mat4Vec3Transformation", func() {
+		matrix := Mat4Prod(TranslationMat4[float32](1.0, 2.0, 3.0), ScaleMat4[float32](2.0, 2.0, 2.0))
+		Expect(Mat4Vec3Transformation(matrix, NewVec3[float32](1.0, 1.0, 1.0))).To(Equal(NewVec3[float32](3.0, 4.0, 5.0)))
+	})
+
+	Specify("PerspectiveFovMat4", func() {
+		Expect(PerspectiveFovMat4(math.Pi/2.0, 2.0, 1.0, 3.0)).To(Equal(PerspectiveMat4(-2.0, 2.0, -1.0, 1.0, 1.0, 3.0)))
+	})
+
+	Specify("#TRS", func() {
+		rotation := RotationQuat(math.Pi/3.0, BasisYVec3[float64]())
+		matrix := TRSMat4(NewVec3(1.0, 2.0, 3.0), rotation, NewVec3(2.0, 3.0, 4.0))
+		translation, actualRotation, scale := matrix.TRS()
+		Expect(translation).To(Equal(NewVec3(1.0, 2.0, 3.0)))
+		Expect(QuatDot(actualRotation, rotation)).To(BeNumerically("~", 1.0, 0.000000000001))
+		Expect(scale.X).To(BeNumerically("~", 2.0, 0.000000000001))
+		Expect(scale.Y).To(BeNumerically("~", 3.0, 0.000000000001))
+		Expect(scale.Z).To(BeNumerically("~", 4.0, 0.000000000001))
+	})
+})
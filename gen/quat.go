@@ -0,0 +1,632 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// RotationOrder specifies the order in which rotations are applied.
+type RotationOrder uint8
+
+const (
+	// RotationOrderGlobalXYZ specifies that rotations are applied in the order
+	// of X, Y, Z using a global gizmo.
+	RotationOrderGlobalXYZ RotationOrder = iota
+
+	// RotationOrderGlobalXZY specifies that rotations are applied in the order
+	// of X, Z, Y using a global gizmo.
+	RotationOrderGlobalXZY
+
+	// RotationOrderGlobalYXZ specifies that rotations are applied in the order
+	// of Y, X, Z using a global gizmo.
+	RotationOrderGlobalYXZ
+
+	// RotationOrderGlobalYZX specifies that rotations are applied in the order
+	// of Y, Z, X using a global gizmo.
+	RotationOrderGlobalYZX
+
+	// RotationOrderGlobalZXY specifies that rotations are applied in the order
+	// of Z, X, Y using a global gizmo.
+	RotationOrderGlobalZXY
+
+	// RotationOrderGlobalZYX specifies that rotations are applied in the order
+	// of Z, Y, X using a global gizmo.
+	RotationOrderGlobalZYX
+)
+
+const (
+	// RotationOrderLocalXYZ specifies that rotations are applied in the order
+	// of X, Y, Z using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalXYZ = RotationOrderGlobalZYX
+
+	// RotationOrderLocalXZY specifies that rotations are applied in the order
+	// of X, Z, Y using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalXZY = RotationOrderGlobalYZX
+
+	// RotationOrderLocalYXZ specifies that rotations are applied in the order
+	// of Y, X, Z using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalYXZ = RotationOrderGlobalZXY
+
+	// RotationOrderLocalYZX specifies that rotations are applied in the order
+	// of Y, Z, X using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalYZX = RotationOrderGlobalXZY
+
+	// RotationOrderLocalZXY specifies that rotations are applied in the order
+	// of Z, X, Y using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalZXY = RotationOrderGlobalYXZ
+
+	// RotationOrderLocalZYX specifies that rotations are applied in the order
+	// of Z, Y, X using a local gizmo (i.e. from the point of view of the object).
+	RotationOrderLocalZYX = RotationOrderGlobalXYZ
+)
+
+// NewQuat creates a quaternion with the given W, X, Y, and Z components.
+func NewQuat[T Float](w, x, y, z T) Quat[T] {
+	return Quat[T]{
+		W: w,
+		X: x,
+		Y: y,
+		Z: z,
+	}
+}
+
+// IdentityQuat returns the identity quaternion (no rotation).
+func IdentityQuat[T Float]() Quat[T] {
+	return Quat[T]{
+		W: 1.0,
+		X: 0.0,
+		Y: 0.0,
+		Z: 0.0,
+	}
+}
+
+// NegativeQuat returns the negation of the given quaternion.
+func NegativeQuat[T Float](q Quat[T]) Quat[T] {
+	return Quat[T]{
+		W: -q.W,
+		X: -q.X,
+		Y: -q.Y,
+		Z: -q.Z,
+	}
+}
+
+// RotationQuat returns a quaternion representing a rotation of angle in
+// radians around the given axis direction.
+func RotationQuat[T Float](angle T, direction Vec3[T]) Quat[T] {
+	cs := cos(angle / 2.0)
+	sn := sin(angle / 2.0)
+	normalizedDirection := UnitVec3(direction)
+	return Quat[T]{
+		W: cs,
+		X: sn * normalizedDirection.X,
+		Y: sn * normalizedDirection.Y,
+		Z: sn * normalizedDirection.Z,
+	}
+}
+
+// EulerQuat returns a quaternion from the given Euler angles in radians and
+// rotation order.
+func EulerQuat[T Float](x, y, z T, order RotationOrder) Quat[T] {
+	xRot := RotationQuat(x, BasisXVec3[T]())
+	yRot := RotationQuat(y, BasisYVec3[T]())
+	zRot := RotationQuat(z, BasisZVec3[T]())
+	switch order {
+	case RotationOrderGlobalXYZ:
+		return QuatProd(QuatProd(zRot, yRot), xRot)
+	case RotationOrderGlobalXZY:
+		return QuatProd(QuatProd(yRot, zRot), xRot)
+	case RotationOrderGlobalYXZ:
+		return QuatProd(QuatProd(zRot, xRot), yRot)
+	case RotationOrderGlobalYZX:
+		return QuatProd(QuatProd(xRot, zRot), yRot)
+	case RotationOrderGlobalZXY:
+		return QuatProd(QuatProd(yRot, xRot), zRot)
+	case RotationOrderGlobalZYX:
+		return QuatProd(QuatProd(xRot, yRot), zRot)
+	default:
+		return IdentityQuat[T]()
+	}
+}
+
+// ShortestArcQuat returns the quaternion that rotates the from direction
+// onto the to direction along the shortest arc. The two vectors need not
+// be normalized. If they point in nearly opposite directions, the rotation
+// is by 180 degrees around an axis perpendicular to from, as returned by
+// NormalVec3.
+func ShortestArcQuat[T Float](from, to Vec3[T]) Quat[T] {
+	from = UnitVec3(from)
+	to = UnitVec3(to)
+	dot := Vec3Dot(from, to)
+	// The threshold does not depend on the precision, since the cross
+	// product of nearly opposite vectors is too small to reliably define
+	// the rotation axis even with float64 values.
+	if dot < -1.0+0.000001 {
+		axis := NormalVec3(from)
+		return NewQuat(0.0, axis.X, axis.Y, axis.Z)
+	}
+	axis := Vec3Cross(from, to)
+	return UnitQuat(NewQuat(1.0+dot, axis.X, axis.Y, axis.Z))
+}
+
+// LookRotationQuat returns the orientation of an object that looks along
+// the forward direction. The up vector specifies the approximate upward
+// direction of the object. If it is parallel to forward, a perpendicular
+// direction returned by NormalVec3 is used instead.
+//
+// This matches the convention of LookAtTransformationMat4, which means that
+// the object looks along its negative Z axis, with X pointing to the right
+// and Y pointing up.
+func LookRotationQuat[T Float](forward, up Vec3[T]) Quat[T] {
+	orientZ := UnitVec3(InverseVec3(forward))
+	orientX := Vec3Cross(up, orientZ)
+	if orientX.SqrLength() < Epsilon[T]() {
+		orientX = Vec3Cross(NormalVec3(orientZ), orientZ)
+	}
+	orientX = UnitVec3(orientX)
+	orientY := Vec3Cross(orientZ, orientX)
+	return OrientationMat4(orientX, orientY, orientZ).Rotation()
+}
+
+// ConjugateQuat returns the conjugate of the given quaternion.
+func ConjugateQuat[T Float](q Quat[T]) Quat[T] {
+	return Quat[T]{
+		W: q.W,
+		X: -q.X,
+		Y: -q.Y,
+		Z: -q.Z,
+	}
+}
+
+// QuatScalarProd multiplies a quaternion by a scalar value.
+func QuatScalarProd[T Float](q Quat[T], value T) Quat[T] {
+	return Quat[T]{
+		W: q.W * value,
+		X: q.X * value,
+		Y: q.Y * value,
+		Z: q.Z * value,
+	}
+}
+
+// QuatScalarQuot divides a quaternion by a scalar value.
+func QuatScalarQuot[T Float](q Quat[T], value T) Quat[T] {
+	invValue := 1.0 / value
+	return Quat[T]{
+		W: q.W * invValue,
+		X: q.X * invValue,
+		Y: q.Y * invValue,
+		Z: q.Z * invValue,
+	}
+}
+
+// QuatProd returns the product of two quaternions, applying second's rotation
+// after first's.
+func QuatProd[T Float](first, second Quat[T]) Quat[T] {
+	return Quat[T]{
+		W: first.W*second.W - first.X*second.X - first.Y*second.Y - first.Z*second.Z,
+		X: first.W*second.X + first.X*second.W + first.Y*second.Z - first.Z*second.Y,
+		Y: first.W*second.Y - first.X*second.Z + first.Y*second.W + first.Z*second.X,
+		Z: first.W*second.Z + first.X*second.Y - first.Y*second.X + first.Z*second.W,
+	}
+}
+
+// QuatDot returns the dot product of two quaternions.
+func QuatDot[T Float](a, b Quat[T]) T {
+	return a.W*b.W + a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+// QuatLerp returns the linear interpolation between first and second using t.
+// A value of t=0 returns first and t=1 returns second.
+func QuatLerp[T Float](first, second Quat[T], t T) Quat[T] {
+	return Quat[T]{
+		W: first.W + t*(second.W-first.W),
+		X: first.X + t*(second.X-first.X),
+		Y: first.Y + t*(second.Y-first.Y),
+		Z: first.Z + t*(second.Z-first.Z),
+	}
+}
+
+// QuatNLerp returns the normalized linear interpolation between first and
+// second using t.
+func QuatNLerp[T Float](first, second Quat[T], t T) Quat[T] {
+	return UnitQuat(QuatLerp(first, second, t))
+}
+
+// QuatDiff returns the rotation from first to second. When shortest is true,
+// the result uses the shorter arc between the two orientations.
+func QuatDiff[T Float](second, first Quat[T], shortest bool) Quat[T] {
+	if shortest && (QuatDot(second, first) < 0) {
+		second = NegativeQuat(second)
+	}
+	return QuatProd(second, ConjugateQuat(first))
+}
+
+// QuatPow raises the quaternion to the given power.
+func QuatPow[T Float](q Quat[T], pow T) Quat[T] {
+	if q.W > 1.0 {
+		return IdentityQuat[T]()
+	}
+	if q.W < -1.0 {
+		return NegativeQuat(IdentityQuat[T]())
+	}
+	norm := NewVec3(q.X, q.Y, q.Z)
+	if norm.IsZero() {
+		return IdentityQuat[T]()
+	}
+	angle := (2 * acos(q.W)) * pow
+	return RotationQuat(angle, norm)
+}
+
+// QuatExp returns the exponential of the quaternion.
+func QuatExp[T Float](q Quat[T]) Quat[T] {
+	vector := NewVec3(q.X, q.Y, q.Z)
+	scale := exp(q.W)
+	angle := vector.Length()
+	if angle < Epsilon[T]() {
+		return NewQuat(scale, scale*q.X, scale*q.Y, scale*q.Z)
+	}
+	vector = Vec3Prod(vector, scale*sin(angle)/angle)
+	return NewQuat(scale*cos(angle), vector.X, vector.Y, vector.Z)
+}
+
+// QuatLog returns the natural logarithm of the quaternion. For a unit
+// quaternion that represents a rotation of angle around some axis, the
+// result is the pure quaternion with vector part axis * angle / 2.
+func QuatLog[T Float](q Quat[T]) Quat[T] {
+	vector := NewVec3(q.X, q.Y, q.Z)
+	norm := q.Norm()
+	length := vector.Length()
+	if length < Epsilon[T]() {
+		return NewQuat(log(norm), 0.0, 0.0, 0.0)
+	}
+	vector = Vec3Prod(vector, atan2(length, q.W)/length)
+	return NewQuat(log(norm), vector.X, vector.Y, vector.Z)
+}
+
+// AngularVelocityVec3 returns the constant angular velocity, in radians
+// per unit of time, that rotates the first orientation into the second one
+// over the duration dt, taking the shortest path. The velocity is in world
+// space, which means that its direction is the axis of rotation and its
+// length is the angular speed.
+func AngularVelocityVec3[T Float](first, second Quat[T], dt T) Vec3[T] {
+	delta := QuatLog(UnitQuat(QuatDiff(second, first, true)))
+	return Vec3Prod(NewVec3(delta.X, delta.Y, delta.Z), 2.0/dt)
+}
+
+// AngularVelocityQuat returns the rotation that results from applying the
+// specified angular velocity for the duration dt. This is the inverse of
+// AngularVelocityVec3, such that QuatProd(AngularVelocityQuat(velocity, dt),
+// first) is the second orientation.
+func AngularVelocityQuat[T Float](velocity Vec3[T], dt T) Quat[T] {
+	halfAngle := Vec3Prod(velocity, dt/2.0)
+	return QuatExp(NewQuat(0.0, halfAngle.X, halfAngle.Y, halfAngle.Z))
+}
+
+// QuatFirstOrderIntegration returns the orientation q after it has been
+// rotated by the world space angular velocity for the duration dt, using
+// first-order (explicit Euler) integration. The result is normalized.
+//
+// This is the cheapest option and is accurate for small rotations per step.
+// For an exact result use AngularVelocityQuat instead.
+func QuatFirstOrderIntegration[T Float](q Quat[T], velocity Vec3[T], dt T) Quat[T] {
+	spin := Vec3Prod(velocity, dt/2.0)
+	derivative := QuatProd(NewQuat(0.0, spin.X, spin.Y, spin.Z), q)
+	return UnitQuat(NewQuat(
+		q.W+derivative.W,
+		q.X+derivative.X,
+		q.Y+derivative.Y,
+		q.Z+derivative.Z,
+	))
+}
+
+// QuatSecondOrderIntegration is like QuatFirstOrderIntegration but uses a
+// second-order Taylor expansion, which reduces the error for larger
+// rotations per step at a small extra cost.
+func QuatSecondOrderIntegration[T Float](q Quat[T], velocity Vec3[T], dt T) Quat[T] {
+	spin := Vec3Prod(velocity, dt/2.0)
+	delta := NewQuat(1.0-spin.SqrLength()/2.0, spin.X, spin.Y, spin.Z)
+	return UnitQuat(QuatProd(delta, q))
+}
+
+// QuatSlerp returns the spherical linear interpolation between first and
+// second using t.
+func QuatSlerp[T Float](first, second Quat[T], t T) Quat[T] {
+	delta := QuatDiff(second, first, true)
+	fractDelta := QuatPow(delta, t)
+	return QuatProd(fractDelta, first)
+}
+
+// QuatSquad returns the spherical quadrangle interpolation (SQUAD) between
+// first and second using t, where firstControl and secondControl are the
+// intermediate control points of first and second, as returned by
+// QuatSquadControl. A value of t=0 returns first and t=1 returns second.
+func QuatSquad[T Float](first, firstControl, secondControl, second Quat[T], t T) Quat[T] {
+	return QuatSlerp(
+		QuatSlerp(first, second, t),
+		QuatSlerp(firstControl, secondControl, t),
+		2.0*t*(1.0-t),
+	)
+}
+
+// QuatSquadControl returns the intermediate SQUAD control point of current,
+// such that the interpolation through prev, current and next is smooth at
+// current. The quaternions need to be normalized.
+func QuatSquadControl[T Float](prev, current, next Quat[T]) Quat[T] {
+	if QuatDot(prev, current) < 0.0 {
+		prev = NegativeQuat(prev)
+	}
+	if QuatDot(next, current) < 0.0 {
+		next = NegativeQuat(next)
+	}
+	inverse := ConjugateQuat(current)
+	toPrev := QuatLog(QuatProd(inverse, prev))
+	toNext := QuatLog(QuatProd(inverse, next))
+	tangent := NewQuat(
+		-(toPrev.W+toNext.W)/4.0,
+		-(toPrev.X+toNext.X)/4.0,
+		-(toPrev.Y+toNext.Y)/4.0,
+		-(toPrev.Z+toNext.Z)/4.0,
+	)
+	return QuatProd(current, QuatExp(tangent))
+}
+
+// QuatVec3Rotation rotates the vector v by the quaternion q.
+func QuatVec3Rotation[T Float](q Quat[T], v Vec3[T]) Vec3[T] {
+	// Uses the direct formula: v + 2.0 * cross(q.xyz, cross(q.xyz, v) + q.w * v)
+	// See https://en.wikipedia.org/wiki/Quaternions_and_spatial_rotation#Used_methods
+	qAxis := NewVec3(q.X, q.Y, q.Z)
+	shared := Vec3Prod(Vec3Cross(qAxis, v), 2.0)
+	return Vec3Sum(
+		Vec3Sum(v, Vec3Prod(shared, q.W)),
+		Vec3Cross(qAxis, shared),
+	)
+}
+
+// UnitQuat returns the normalized quaternion.
+func UnitQuat[T Float](q Quat[T]) Quat[T] {
+	return QuatScalarQuot(q, q.Norm())
+}
+
+// InverseQuat returns the multiplicative inverse of the given quaternion.
+func InverseQuat[T Float](q Quat[T]) Quat[T] {
+	return QuatScalarQuot(ConjugateQuat(q), q.SqrNorm())
+}
+
+// ClampTwistQuat limits the twist of the rotation around axis, as returned
+// by Quat.SwingTwist, to the range [minAngle, maxAngle] in radians. The swing
+// is preserved. The angles are signed, following the right-hand rule around
+// axis, and should be within [-Pi, Pi].
+func ClampTwistQuat[T Float](q Quat[T], axis Vec3[T], minAngle, maxAngle T) Quat[T] {
+	axis = UnitVec3(axis)
+	swing, twist := q.SwingTwist(axis)
+	angle := 2.0 * atan2(Vec3Dot(NewVec3(twist.X, twist.Y, twist.Z), axis), twist.W)
+	if angle >= minAngle && angle <= maxAngle {
+		return q
+	}
+	angle = min(max(angle, minAngle), maxAngle)
+	return QuatProd(swing, RotationQuat(angle, axis))
+}
+
+// ClampSwingQuat limits the swing of the rotation away from axis, as
+// returned by Quat.SwingTwist, to a cone with the specified maximum angle
+// in radians. The twist around axis is preserved.
+func ClampSwingQuat[T Float](q Quat[T], axis Vec3[T], maxAngle T) Quat[T] {
+	return ClampSwingEllipseQuat(q, axis, NormalVec3(axis), maxAngle, maxAngle)
+}
+
+// ClampSwingEllipseQuat limits the swing of the rotation away from axis, as
+// returned by Quat.SwingTwist, to an elliptical cone. The swing towards the
+// reference direction (and away from it) is limited to maxAngleA radians and
+// the swing towards Vec3Cross(axis, reference) is limited to maxAngleB
+// radians. The reference direction needs to not be parallel to axis.
+//
+// Swings outside of the cone are scaled down towards the axis, keeping
+// their direction, until they reach its boundary. The twist around axis is
+// preserved.
+func ClampSwingEllipseQuat[T Float](q Quat[T], axis, reference Vec3[T], maxAngleA, maxAngleB T) Quat[T] {
+	axis = UnitVec3(axis)
+	dirA := UnitVec3(Vec3Diff(reference, Vec3Prod(axis, Vec3Dot(reference, axis))))
+	dirB := Vec3Cross(axis, dirA)
+
+	swing, twist := q.SwingTwist(axis)
+	if swing.W < 0.0 {
+		swing = NegativeQuat(swing)
+	}
+	swingAxis := NewVec3(swing.X, swing.Y, swing.Z)
+	sn := swingAxis.Length()
+	if sn < Epsilon[T]() {
+		return q
+	}
+	angle := 2.0 * atan2(sn, swing.W)
+
+	// Rotating around dirB tilts axis towards dirA and rotating around dirA
+	// tilts axis away from dirB.
+	tiltA := angle * Vec3Dot(swingAxis, dirB) / sn
+	tiltB := -angle * Vec3Dot(swingAxis, dirA) / sn
+	ratio := sqrt(sqrEllipseRatio(tiltA, maxAngleA) + sqrEllipseRatio(tiltB, maxAngleB))
+	if ratio <= 1.0 {
+		return q
+	}
+	return QuatProd(RotationQuat(angle/ratio, swingAxis), twist)
+}
+
+func sqrEllipseRatio[T Float](value, limit T) T {
+	if value == 0.0 {
+		return 0.0
+	}
+	ratio := value / limit
+	return ratio * ratio
+}
+
+// Quat is a quaternion with components of type T.
+type Quat[T Float] struct {
+	W T
+	X T
+	Y T
+	Z T
+}
+
+// IsNaN returns true if any component is NaN.
+func (q Quat[T]) IsNaN() bool {
+	return math.IsNaN(float64(q.X)) || math.IsNaN(float64(q.Y)) || math.IsNaN(float64(q.Z)) || math.IsNaN(float64(q.W))
+}
+
+// IsInf returns true if any component is Inf.
+func (q Quat[T]) IsInf() bool {
+	return math.IsInf(float64(q.X), 0) || math.IsInf(float64(q.Y), 0) || math.IsInf(float64(q.Z), 0) || math.IsInf(float64(q.W), 0)
+}
+
+// IsIdentity returns true if this is the identity quaternion.
+func (q Quat[T]) IsIdentity() bool {
+	return eq(q.X, 0.0) && eq(q.Y, 0.0) && eq(q.Z, 0.0) && eq(q.W, 1.0)
+}
+
+// SqrNorm returns the squared norm of the quaternion.
+func (q Quat[T]) SqrNorm() T {
+	return q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z
+}
+
+// Norm returns the norm (magnitude) of the quaternion.
+func (q Quat[T]) Norm() T {
+	return sqrt(q.SqrNorm())
+}
+
+// OrientationX returns the X basis vector of the rotation.
+func (q Quat[T]) OrientationX() Vec3[T] {
+	return Vec3[T]{
+		X: 1.0 - 2.0*(q.Y*q.Y+q.Z*q.Z),
+		Y: 2.0 * (q.X*q.Y + q.W*q.Z),
+		Z: 2.0 * (q.X*q.Z - q.W*q.Y),
+	}
+}
+
+// OrientationY returns the Y basis vector of the rotation.
+func (q Quat[T]) OrientationY() Vec3[T] {
+	return Vec3[T]{
+		X: 2.0 * (q.X*q.Y - q.W*q.Z),
+		Y: 1.0 - 2.0*(q.X*q.X+q.Z*q.Z),
+		Z: 2.0 * (q.Y*q.Z + q.W*q.X),
+	}
+}
+
+// OrientationZ returns the Z basis vector of the rotation.
+func (q Quat[T]) OrientationZ() Vec3[T] {
+	return Vec3[T]{
+		X: 2.0 * (q.X*q.Z + q.W*q.Y),
+		Y: 2.0 * (q.Y*q.Z - q.W*q.X),
+		Z: 1.0 - 2.0*(q.X*q.X+q.Y*q.Y),
+	}
+}
+
+// AxisAngle returns the rotation axis and the angle in radians of the
+// quaternion, which is the inverse of RotationQuat. The angle is in the
+// range [0, 2*Pi] and the axis is a unit vector. If there is no rotation,
+// the X basis vector is returned as the axis.
+func (q Quat[T]) AxisAngle() (Vec3[T], T) {
+	axis := NewVec3(q.X, q.Y, q.Z)
+	sn := axis.Length()
+	if sn < Epsilon[T]() {
+		return BasisXVec3[T](), 0.0
+	}
+	return Vec3Quot(axis, sn), 2.0 * atan2(sn, q.W)
+}
+
+// SwingTwist decomposes the rotation into a twist around the specified axis
+// and a swing around an axis that is perpendicular to it, such that the
+// rotation is equal to QuatProd(swing, twist). That is, the twist is applied
+// first, followed by the swing.
+//
+// If the rotation is a half turn around an axis perpendicular to axis, the
+// twist is ambiguous and the identity quaternion is returned for it.
+//
+// NOTE: This assumes that the quaternion is normalized.
+func (q Quat[T]) SwingTwist(axis Vec3[T]) (swing, twist Quat[T]) {
+	axis = UnitVec3(axis)
+	projection := Vec3Prod(axis, Vec3Dot(NewVec3(q.X, q.Y, q.Z), axis))
+	twist = NewQuat(q.W, projection.X, projection.Y, projection.Z)
+	if twist.SqrNorm() < Epsilon[T]() {
+		return q, IdentityQuat[T]()
+	}
+	twist = UnitQuat(twist)
+	if twist.W < 0.0 {
+		twist = NegativeQuat(twist)
+	}
+	swing = QuatProd(q, ConjugateQuat(twist))
+	return swing, twist
+}
+
+// EulerAngles returns the Euler rotation angles in radians for the given
+// quaternion and rotation order in which it was presumably created.
+//
+// The rotations are always returned for X, Y, Z axis in that order.
+//
+// NOTE: This assumes that the quaternion is normalized.
+func (q Quat[T]) EulerAngles(order RotationOrder) (x T, y T, z T) {
+	switch order {
+	case RotationOrderGlobalXYZ:
+		x = atan2(
+			2.0*(q.W*q.X+q.Y*q.Z),
+			1.0-2.0*(q.X*q.X+q.Y*q.Y),
+		)
+		y = asin(2.0 * (q.W*q.Y - q.X*q.Z))
+		z = atan2(
+			2.0*(q.W*q.Z+q.X*q.Y),
+			1.0-2.0*(q.Y*q.Y+q.Z*q.Z),
+		)
+	case RotationOrderGlobalXZY:
+		x = atan2(
+			2.0*(q.W*q.X-q.Z*q.Y),
+			1.0-2.0*(q.X*q.X+q.Z*q.Z),
+		)
+		y = atan2(
+			2.0*(q.W*q.Y-q.X*q.Z),
+			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
+		)
+		z = asin(2.0 * (q.W*q.Z + q.X*q.Y))
+	case RotationOrderGlobalYXZ:
+		x = asin(2.0 * (q.W*q.X + q.Z*q.Y))
+		y = atan2(
+			2.0*(q.W*q.Y-q.Z*q.X),
+			1.0-2.0*(q.X*q.X+q.Y*q.Y),
+		)
+		z = atan2(
+			2.0*(q.W*q.Z-q.X*q.Y),
+			1.0-2.0*(q.Z*q.Z+q.X*q.X),
+		)
+	case RotationOrderGlobalYZX:
+		x = atan2(
+			2.0*(q.W*q.X+q.Z*q.Y),
+			1.0-2.0*(q.X*q.X+q.Z*q.Z),
+		)
+		y = atan2(
+			2.0*(q.W*q.Y+q.X*q.Z),
+			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
+		)
+		z = asin(2.0 * (q.W*q.Z - q.X*q.Y))
+	case RotationOrderGlobalZXY:
+		x = asin(2.0 * (q.W*q.X - q.Z*q.Y))
+		y = atan2(
+			2.0*(q.W*q.Y+q.Z*q.X),
+			1.0-2.0*(q.X*q.X+q.Y*q.Y),
+		)
+		z = atan2(
+			2.0*(q.W*q.Z+q.X*q.Y),
+			1.0-2.0*(q.Z*q.Z+q.X*q.X),
+		)
+	case RotationOrderGlobalZYX:
+		x = atan2(
+			2.0*(q.W*q.X-q.Z*q.Y),
+			1.0-2.0*(q.Y*q.Y+q.X*q.X),
+		)
+		y = asin(2.0 * (q.W*q.Y + q.Z*q.X))
+		z = atan2(
+			2.0*(q.W*q.Z-q.Y*q.X),
+			1.0-2.0*(q.Z*q.Z+q.Y*q.Y),
+		)
+	}
+	return
+}
+
+// String returns a string representation of the quaternion.
+func (q Quat[T]) String() string {
+	return fmt.Sprintf("(%f, %f, %f, %f)", q.W, q.X, q.Y, q.Z)
+}
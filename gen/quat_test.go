@@ -0,0 +1,46 @@
+package gen_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Quat", func() {
+	Specify("RotationQuat", func() {
+		quat := RotationQuat(math.Pi/2.0, BasisZVec3[float64]())
+		vector := QuatVec3Rotation(quat, BasisXVec3[float64]())
+		Expect(vector.X).To(BeNumerically("~", 0.0, 0.000000000001))
+		Expect(vector.Y).To(BeNumerically("~", 1.0, 0.000000000001))
+		Expect(vector.Z).To(BeNumerically("~", 0.0, 0.000000000001))
+	})
+
+	Specify("ShortestArcQuat", func() {
+		from := NewVec3[float32](0.0, 0.0, 1.0)
+		to := NewVec3[float32](0.0, 0.0, -1.0)
+		quat := ShortestArcQuat(from, to)
+		vector := QuatVec3Rotation(quat, from)
+		Expect(vector.X).To(BeNumerically("~", 0.0, 0.000001))
+		Expect(vector.Y).To(BeNumerically("~", 0.0, 0.000001))
+		Expect(vector.Z).To(BeNumerically("~", -1.0, 0.000001))
+	})
+
+	Specify("QuatSlerp", func() {
+		first := IdentityQuat[float32]()
+		second := RotationQuat(float32(math.Pi/2.0), BasisYVec3[float32]())
+		result := QuatSlerp(first, second, 0.5)
+		expected := RotationQuat(float32(math.Pi/4.0), BasisYVec3[float32]())
+		Expect(QuatDot(result, expected)).To(BeNumerically("~", 1.0, 0.000001))
+	})
+
+	Specify("#EulerAngles", func() {
+		quat := EulerQuat(0.1, 0.2, 0.3, RotationOrderGlobalXYZ)
+		x, y, z := quat.EulerAngles(RotationOrderGlobalXYZ)
+		Expect(x).To(BeNumerically("~", 0.1, 0.000000000001))
+		Expect(y).To(BeNumerically("~", 0.2, 0.000000000001))
+		Expect(z).To(BeNumerically("~", 0.3, 0.000000000001))
+	})
+})
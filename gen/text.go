@@ -0,0 +1,394 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseVec2 parses a Vec2 from text of the form "(x, y)", which is the
+// format produced by String and MarshalText.
+func ParseVec2[T Float](text string) (Vec2[T], error) {
+	values, err := parseVector[T](text, 2)
+	if err != nil {
+		return Vec2[T]{}, fmt.Errorf("invalid Vec2 %q: %w", text, err)
+	}
+	return NewVec2(values[0], values[1]), nil
+}
+
+// ParseVec3 parses a Vec3 from text of the form "(x, y, z)", which is the
+// format produced by String and MarshalText.
+func ParseVec3[T Float](text string) (Vec3[T], error) {
+	values, err := parseVector[T](text, 3)
+	if err != nil {
+		return Vec3[T]{}, fmt.Errorf("invalid Vec3 %q: %w", text, err)
+	}
+	return NewVec3(values[0], values[1], values[2]), nil
+}
+
+// ParseVec4 parses a Vec4 from text of the form "(x, y, z, w)", which is
+// the format produced by String and MarshalText.
+func ParseVec4[T Float](text string) (Vec4[T], error) {
+	values, err := parseVector[T](text, 4)
+	if err != nil {
+		return Vec4[T]{}, fmt.Errorf("invalid Vec4 %q: %w", text, err)
+	}
+	return NewVec4(values[0], values[1], values[2], values[3]), nil
+}
+
+// ParseQuat parses a Quat from text of the form "(w, x, y, z)", which is
+// the format produced by String and MarshalText.
+func ParseQuat[T Float](text string) (Quat[T], error) {
+	values, err := parseVector[T](text, 4)
+	if err != nil {
+		return Quat[T]{}, fmt.Errorf("invalid Quat %q: %w", text, err)
+	}
+	return NewQuat(values[0], values[1], values[2], values[3]), nil
+}
+
+// ParseMat2 parses a Mat2 from text that lists the rows of the matrix in
+// the form "((m11, m12), (m21, m22))", which is the format produced by
+// String and MarshalText.
+func ParseMat2[T Float](text string) (Mat2[T], error) {
+	values, err := parseMatrix[T](text, 2)
+	if err != nil {
+		return Mat2[T]{}, fmt.Errorf("invalid Mat2 %q: %w", text, err)
+	}
+	return RowMajorArrayToMat2([4]T(values)), nil
+}
+
+// ParseMat3 parses a Mat3 from text that lists the rows of the matrix in
+// the form "((m11, m12, m13), (m21, m22, m23), (m31, m32, m33))", which is
+// the format produced by String and MarshalText.
+func ParseMat3[T Float](text string) (Mat3[T], error) {
+	values, err := parseMatrix[T](text, 3)
+	if err != nil {
+		return Mat3[T]{}, fmt.Errorf("invalid Mat3 %q: %w", text, err)
+	}
+	return RowMajorArrayToMat3([9]T(values)), nil
+}
+
+// ParseMat4 parses a Mat4 from text that lists the rows of the matrix in
+// the form "((m11, m12, m13, m14), ..., (m41, m42, m43, m44))", which is
+// the format produced by String and MarshalText.
+func ParseMat4[T Float](text string) (Mat4[T], error) {
+	values, err := parseMatrix[T](text, 4)
+	if err != nil {
+		return Mat4[T]{}, fmt.Errorf("invalid Mat4 %q: %w", text, err)
+	}
+	return RowMajorArrayToMat4([16]T(values)), nil
+}
+
+// ParseAngle parses an angle and returns it in radians. The text can be a
+// plain number of radians or a number with a "deg" or "rad" unit suffix
+// (e.g. "90deg" or "1.5 rad").
+func ParseAngle[T Float](text string) (T, error) {
+	radians, err := parseAngle[T](text, false)
+	if err != nil {
+		return 0.0, fmt.Errorf("invalid Angle %q: %w", text, err)
+	}
+	return radians, nil
+}
+
+// AppendAngleText appends the text form of an angle, which is the number
+// of radians followed by a "rad" unit suffix.
+func AppendAngleText[T Float](data []byte, radians T) []byte {
+	data = appendTextNumber(data, radians)
+	return append(data, "rad"...)
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec2[T]) AppendText(data []byte) ([]byte, error) {
+	return appendTextTuple(data, v.X, v.Y), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec2[T]) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec2[T]) UnmarshalText(text []byte) error {
+	result, err := ParseVec2[T](string(text))
+	if err != nil {
+		return err
+	}
+	*v = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec3[T]) AppendText(data []byte) ([]byte, error) {
+	return appendTextTuple(data, v.X, v.Y, v.Z), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec3[T]) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec3[T]) UnmarshalText(text []byte) error {
+	result, err := ParseVec3[T](string(text))
+	if err != nil {
+		return err
+	}
+	*v = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (v Vec4[T]) AppendText(data []byte) ([]byte, error) {
+	return appendTextTuple(data, v.X, v.Y, v.Z, v.W), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec4[T]) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec4[T]) UnmarshalText(text []byte) error {
+	result, err := ParseVec4[T](string(text))
+	if err != nil {
+		return err
+	}
+	*v = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (q Quat[T]) AppendText(data []byte) ([]byte, error) {
+	return appendTextTuple(data, q.W, q.X, q.Y, q.Z), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (q Quat[T]) MarshalText() ([]byte, error) {
+	return q.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (q *Quat[T]) UnmarshalText(text []byte) error {
+	result, err := ParseQuat[T](string(text))
+	if err != nil {
+		return err
+	}
+	*q = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat2[T]) AppendText(data []byte) ([]byte, error) {
+	data = append(data, '(')
+	data = appendTextTuple(data, m.M11, m.M12)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M21, m.M22)
+	return append(data, ')'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat2[T]) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat2[T]) UnmarshalText(text []byte) error {
+	result, err := ParseMat2[T](string(text))
+	if err != nil {
+		return err
+	}
+	*m = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat3[T]) AppendText(data []byte) ([]byte, error) {
+	data = append(data, '(')
+	data = appendTextTuple(data, m.M11, m.M12, m.M13)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M21, m.M22, m.M23)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M31, m.M32, m.M33)
+	return append(data, ')'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat3[T]) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat3[T]) UnmarshalText(text []byte) error {
+	result, err := ParseMat3[T](string(text))
+	if err != nil {
+		return err
+	}
+	*m = result
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. The format is the same as
+// the one of String, except that the components are not rounded.
+func (m Mat4[T]) AppendText(data []byte) ([]byte, error) {
+	data = append(data, '(')
+	data = appendTextTuple(data, m.M11, m.M12, m.M13, m.M14)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M21, m.M22, m.M23, m.M24)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M31, m.M32, m.M33, m.M34)
+	data = append(data, ", "...)
+	data = appendTextTuple(data, m.M41, m.M42, m.M43, m.M44)
+	return append(data, ')'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mat4[T]) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mat4[T]) UnmarshalText(text []byte) error {
+	result, err := ParseMat4[T](string(text))
+	if err != nil {
+		return err
+	}
+	*m = result
+	return nil
+}
+
+func appendTextTuple[T Float](data []byte, values ...T) []byte {
+	data = append(data, '(')
+	for i, value := range values {
+		if i > 0 {
+			data = append(data, ", "...)
+		}
+		data = appendTextNumber(data, value)
+	}
+	return append(data, ')')
+}
+
+func appendTextNumber[T Float](data []byte, value T) []byte {
+	return strconv.AppendFloat(data, float64(value), 'g', -1, bitSize[T]())
+}
+
+func parseMatrix[T Float](text string, size int) ([]T, error) {
+	rows, err := splitTextTuple(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != size {
+		return nil, fmt.Errorf("expected %d rows but got %d", size, len(rows))
+	}
+	result := make([]T, 0, size*size)
+	for i, row := range rows {
+		values, err := parseVector[T](row, size)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		result = append(result, values...)
+	}
+	return result, nil
+}
+
+func parseVector[T Float](text string, size int) ([]T, error) {
+	elements, err := splitTextTuple(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) != size {
+		return nil, fmt.Errorf("expected %d components but got %d", size, len(elements))
+	}
+	result := make([]T, size)
+	for i, element := range elements {
+		if result[i], err = parseTextNumber[T](element); err != nil {
+			return nil, fmt.Errorf("component %d: %w", i+1, err)
+		}
+	}
+	return result, nil
+}
+
+// splitTextTuple splits text of the form "(a, b, c)" into its trimmed
+// elements. Parenthesized elements are not split further.
+func splitTextTuple(text string) ([]string, error) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "(") {
+		return nil, errors.New(`expected "(" at start`)
+	}
+	if !strings.HasSuffix(text, ")") {
+		return nil, errors.New(`expected ")" at end`)
+	}
+	inner := text[1 : len(text)-1]
+	if strings.TrimSpace(inner) == "" {
+		return nil, nil
+	}
+	var (
+		elements []string
+		depth    int
+		start    int
+	)
+	for i, char := range inner {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected \")\" at offset %d", i+1)
+			}
+		case ',':
+			if depth == 0 {
+				elements = append(elements, strings.TrimSpace(inner[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth > 0 {
+		return nil, errors.New(`missing ")"`)
+	}
+	return append(elements, strings.TrimSpace(inner[start:])), nil
+}
+
+func parseTextNumber[T Float](text string) (T, error) {
+	if text == "" {
+		return 0.0, errors.New("missing number")
+	}
+	value, err := strconv.ParseFloat(text, bitSize[T]())
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0.0, fmt.Errorf("number %q is out of range", text)
+		}
+		return 0.0, fmt.Errorf("invalid number %q", text)
+	}
+	return T(value), nil
+}
+
+func parseAngle[T Float](text string, requireUnit bool) (T, error) {
+	text = strings.TrimSpace(text)
+	unit := ""
+	for _, suffix := range []string{"deg", "rad"} {
+		if strings.HasSuffix(text, suffix) {
+			unit = suffix
+			text = strings.TrimSpace(strings.TrimSuffix(text, suffix))
+			break
+		}
+	}
+	if unit == "" && requireUnit {
+		return 0.0, errors.New(`missing "deg" or "rad" unit suffix`)
+	}
+	value, err := parseTextNumber[T](text)
+	if err != nil {
+		return 0.0, err
+	}
+	if unit == "deg" {
+		return T(math.Pi) * (value / 180.0), nil
+	}
+	return value, nil
+}
@@ -0,0 +1,34 @@
+package gen_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Text", func() {
+	Specify("shortest representation per precision", func() {
+		Expect(NewVec2[float32](0.1, 1.0/3.0).MarshalText()).To(Equal([]byte("(0.1, 0.33333334)")))
+		Expect(NewVec2[float64](0.1, 1.0/3.0).MarshalText()).To(Equal([]byte("(0.1, 0.3333333333333333)")))
+	})
+
+	Specify("non-finite values", func() {
+		text, err := NewVec3(math.NaN(), math.Inf(1), math.Inf(-1)).MarshalText()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(text)).To(Equal("(NaN, +Inf, -Inf)"))
+		vec, err := ParseVec3[meters](string(text))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vec.IsNaN()).To(BeTrue())
+		Expect(vec.Y).To(BeNumerically(">", math.MaxFloat64))
+	})
+
+	Specify("ParseAngle", func() {
+		radians, err := ParseAngle[meters]("180deg")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(radians).To(BeNumerically("~", math.Pi, 0.000000000001))
+		Expect(AppendAngleText([]byte("angle="), meters(0.5))).To(Equal([]byte("angle=0.5rad")))
+	})
+})
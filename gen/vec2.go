@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewVec2 creates a Vec2 with the given X and Y components.
+func NewVec2[T Float](x, y T) Vec2[T] {
+	return Vec2[T]{
+		X: x,
+		Y: y,
+	}
+}
+
+// ZeroVec2 returns the zero Vec2.
+func ZeroVec2[T Float]() Vec2[T] {
+	return Vec2[T]{}
+}
+
+// BasisXVec2 returns the unit vector along the X axis.
+func BasisXVec2[T Float]() Vec2[T] {
+	return Vec2[T]{
+		X: 1.0,
+		Y: 0.0,
+	}
+}
+
+// BasisYVec2 returns the unit vector along the Y axis.
+func BasisYVec2[T Float]() Vec2[T] {
+	return Vec2[T]{
+		X: 0.0,
+		Y: 1.0,
+	}
+}
+
+// Vec2Sum returns the sum of two vectors.
+func Vec2Sum[T Float](a, b Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: a.X + b.X,
+		Y: a.Y + b.Y,
+	}
+}
+
+// Vec2MultiSum returns the sum of multiple vectors.
+func Vec2MultiSum[T Float](first Vec2[T], others ...Vec2[T]) Vec2[T] {
+	result := first
+	for _, other := range others {
+		result.X += other.X
+		result.Y += other.Y
+	}
+	return result
+}
+
+// Vec2Diff returns the difference of two vectors (a - b).
+func Vec2Diff[T Float](a, b Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: a.X - b.X,
+		Y: a.Y - b.Y,
+	}
+}
+
+// Vec2MultiDiff subtracts each subsequent vector from the first.
+func Vec2MultiDiff[T Float](first Vec2[T], others ...Vec2[T]) Vec2[T] {
+	result := first
+	for _, other := range others {
+		result.X -= other.X
+		result.Y -= other.Y
+	}
+	return result
+}
+
+// Vec2Prod multiplies a vector by a scalar value.
+func Vec2Prod[T Float](vector Vec2[T], value T) Vec2[T] {
+	return Vec2[T]{
+		X: vector.X * value,
+		Y: vector.Y * value,
+	}
+}
+
+// Vec2Quot divides a vector by a scalar value.
+func Vec2Quot[T Float](vector Vec2[T], value T) Vec2[T] {
+	invValue := 1.0 / value
+	return Vec2[T]{
+		X: vector.X * invValue,
+		Y: vector.Y * invValue,
+	}
+}
+
+// Vec2Dot returns the dot product of two vectors.
+func Vec2Dot[T Float](a, b Vec2[T]) T {
+	return a.X*b.X + a.Y*b.Y
+}
+
+// Vec2Cross returns the 2D cross product (scalar) of two vectors.
+func Vec2Cross[T Float](a, b Vec2[T]) T {
+	return a.X*b.Y - a.Y*b.X
+}
+
+// Vec2Lerp returns the linear interpolation between a and b using t.
+// A value of t=0 returns a and t=1 returns b.
+func Vec2Lerp[T Float](a, b Vec2[T], t T) Vec2[T] {
+	return Vec2[T]{
+		X: a.X + t*(b.X-a.X),
+		Y: a.Y + t*(b.Y-a.Y),
+	}
+}
+
+// UnitVec2 returns the unit (normalized) vector in the direction of vector.
+func UnitVec2[T Float](vector Vec2[T]) Vec2[T] {
+	return Vec2Quot(vector, vector.Length())
+}
+
+// ResizedVec2 returns a vector in the same direction as vector but with
+// the given length.
+func ResizedVec2[T Float](vector Vec2[T], newLength T) Vec2[T] {
+	ratio := newLength / vector.Length()
+	return Vec2Prod(vector, ratio)
+}
+
+// InverseVec2 returns the negation of the given vector.
+func InverseVec2[T Float](vector Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: -vector.X,
+		Y: -vector.Y,
+	}
+}
+
+// NormalVec2 returns a unit vector perpendicular to the given vector,
+// rotated 90 degrees clockwise (the right-hand perpendicular). When the
+// given vector is an edge of a counter-clockwise wound polygon, the
+// returned normal points outward.
+func NormalVec2[T Float](vector Vec2[T]) Vec2[T] {
+	return UnitVec2(Vec2[T]{
+		X: vector.Y,
+		Y: -vector.X,
+	})
+}
+
+// ArrayToVec2 creates a Vec2 from a two-element array.
+func ArrayToVec2[T Float](array [2]T) Vec2[T] {
+	return Vec2[T]{
+		X: array[0],
+		Y: array[1],
+	}
+}
+
+// Vec2 is a two-dimensional vector with components of type T.
+type Vec2[T Float] struct {
+	X T
+	Y T
+}
+
+// IsNaN returns true if any component is NaN.
+func (v Vec2[T]) IsNaN() bool {
+	return math.IsNaN(float64(v.X)) || math.IsNaN(float64(v.Y))
+}
+
+// IsInf returns true if any component is Inf.
+func (v Vec2[T]) IsInf() bool {
+	return math.IsInf(float64(v.X), 0) || math.IsInf(float64(v.Y), 0)
+}
+
+// IsZero returns true if all components are within Epsilon of zero.
+func (v Vec2[T]) IsZero() bool {
+	return eq(v.X, 0.0) && eq(v.Y, 0.0)
+}
+
+// SqrLength returns the squared length of the vector.
+func (v Vec2[T]) SqrLength() T {
+	return Vec2Dot(v, v)
+}
+
+// Length returns the length of the vector.
+func (v Vec2[T]) Length() T {
+	return sqrt(Vec2Dot(v, v))
+}
+
+// String returns a string representation of the vector.
+func (v Vec2[T]) String() string {
+	return fmt.Sprintf("(%f, %f)", v.X, v.Y)
+}
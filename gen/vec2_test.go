@@ -0,0 +1,30 @@
+package gen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Vec2", func() {
+	Specify("Vec2Sum", func() {
+		Expect(Vec2Sum(NewVec2[float32](1.0, 2.0), NewVec2[float32](3.0, -1.0))).To(Equal(NewVec2[float32](4.0, 1.0)))
+		Expect(Vec2Sum(NewVec2(1.0, 2.0), NewVec2(3.0, -1.0))).To(Equal(NewVec2(4.0, 1.0)))
+	})
+
+	Specify("NormalVec2", func() {
+		Expect(NormalVec2(NewVec2[float32](0.0, 2.0))).To(Equal(NewVec2[float32](1.0, 0.0)))
+		Expect(NormalVec2(NewVec2(0.0, 2.0))).To(Equal(NewVec2(1.0, 0.0)))
+	})
+
+	Specify("#Length", func() {
+		Expect(NewVec2[float32](3.0, 4.0).Length()).To(Equal(float32(5.0)))
+		Expect(NewVec2(3.0, 4.0).Length()).To(Equal(5.0))
+	})
+
+	Specify("#IsZero", func() {
+		Expect(NewVec2[float32](0.0000001, 0.0).IsZero()).To(BeTrue())
+		Expect(NewVec2(0.0000001, 0.0).IsZero()).To(BeFalse())
+	})
+})
@@ -0,0 +1,36 @@
+package gen_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Vec3", func() {
+	Specify("Vec3Cross", func() {
+		Expect(Vec3Cross(BasisXVec3[float32](), BasisYVec3[float32]())).To(Equal(BasisZVec3[float32]()))
+		Expect(Vec3Cross(BasisXVec3[float64](), BasisYVec3[float64]())).To(Equal(BasisZVec3[float64]()))
+	})
+
+	Specify("UnitVec3", func() {
+		Expect(UnitVec3(NewVec3[float32](0.0, 4.0, 0.0))).To(Equal(NewVec3[float32](0.0, 1.0, 0.0)))
+		Expect(UnitVec3(NewVec3(0.0, 0.0, -2.5))).To(Equal(NewVec3(0.0, 0.0, -1.0)))
+	})
+
+	Specify("Vec3Angle", func() {
+		Expect(Vec3Angle(BasisXVec3[float32](), BasisYVec3[float32]())).To(BeNumerically("~", math.Pi/2.0, 0.000001))
+		Expect(Vec3Angle(BasisXVec3[float64](), BasisYVec3[float64]())).To(BeNumerically("~", math.Pi/2.0, 0.000000000001))
+	})
+
+	Specify("Vec3ProjectionAngle", func() {
+		angle := Vec3ProjectionAngle(BasisYVec3[float64](), BasisXVec3[float64](), BasisZVec3[float64]())
+		Expect(angle).To(BeNumerically("~", -math.Pi/2.0, 0.000000000001))
+	})
+
+	Specify("#Array", func() {
+		Expect(NewVec3[meters](1.0, 2.0, 3.0).Array()).To(Equal([3]meters{1.0, 2.0, 3.0}))
+	})
+})
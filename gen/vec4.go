@@ -0,0 +1,175 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewVec4 creates a Vec4 with the given X, Y, Z, and W components.
+func NewVec4[T Float](x, y, z, w T) Vec4[T] {
+	return Vec4[T]{
+		X: x,
+		Y: y,
+		Z: z,
+		W: w,
+	}
+}
+
+// ZeroVec4 returns the zero Vec4.
+func ZeroVec4[T Float]() Vec4[T] {
+	return Vec4[T]{}
+}
+
+// Vec4Sum returns the sum of two vectors.
+func Vec4Sum[T Float](a, b Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: a.X + b.X,
+		Y: a.Y + b.Y,
+		Z: a.Z + b.Z,
+		W: a.W + b.W,
+	}
+}
+
+// Vec4MultiSum returns the sum of multiple vectors.
+func Vec4MultiSum[T Float](first Vec4[T], others ...Vec4[T]) Vec4[T] {
+	result := first
+	for _, other := range others {
+		result.X += other.X
+		result.Y += other.Y
+		result.Z += other.Z
+		result.W += other.W
+	}
+	return result
+}
+
+// Vec4Diff returns the difference of two vectors (a - b).
+func Vec4Diff[T Float](a, b Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: a.X - b.X,
+		Y: a.Y - b.Y,
+		Z: a.Z - b.Z,
+		W: a.W - b.W,
+	}
+}
+
+// Vec4MultiDiff subtracts each subsequent vector from the first.
+func Vec4MultiDiff[T Float](first Vec4[T], others ...Vec4[T]) Vec4[T] {
+	result := first
+	for _, other := range others {
+		result.X -= other.X
+		result.Y -= other.Y
+		result.Z -= other.Z
+		result.W -= other.W
+	}
+	return result
+}
+
+// Vec4Prod multiplies a vector by a scalar value.
+func Vec4Prod[T Float](vector Vec4[T], value T) Vec4[T] {
+	return Vec4[T]{
+		X: vector.X * value,
+		Y: vector.Y * value,
+		Z: vector.Z * value,
+		W: vector.W * value,
+	}
+}
+
+// Vec4Quot divides a vector by a scalar value.
+func Vec4Quot[T Float](vector Vec4[T], value T) Vec4[T] {
+	invValue := 1.0 / value
+	return Vec4[T]{
+		X: vector.X * invValue,
+		Y: vector.Y * invValue,
+		Z: vector.Z * invValue,
+		W: vector.W * invValue,
+	}
+}
+
+// Vec4Dot returns the dot product of two vectors.
+func Vec4Dot[T Float](a, b Vec4[T]) T {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
+}
+
+// Vec4Lerp returns the linear interpolation between a and b using t.
+// A value of t=0 returns a and t=1 returns b.
+func Vec4Lerp[T Float](a, b Vec4[T], t T) Vec4[T] {
+	return Vec4[T]{
+		X: a.X + t*(b.X-a.X),
+		Y: a.Y + t*(b.Y-a.Y),
+		Z: a.Z + t*(b.Z-a.Z),
+		W: a.W + t*(b.W-a.W),
+	}
+}
+
+// InverseVec4 returns the negation of the given vector.
+func InverseVec4[T Float](vector Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: -vector.X,
+		Y: -vector.Y,
+		Z: -vector.Z,
+		W: -vector.W,
+	}
+}
+
+// UnitVec4 returns the unit (normalized) vector in the direction of vector.
+func UnitVec4[T Float](vector Vec4[T]) Vec4[T] {
+	return Vec4Quot(vector, vector.Length())
+}
+
+// ArrayToVec4 creates a Vec4 from a four-element array.
+func ArrayToVec4[T Float](array [4]T) Vec4[T] {
+	return Vec4[T]{
+		X: array[0],
+		Y: array[1],
+		Z: array[2],
+		W: array[3],
+	}
+}
+
+// Vec4 is a four-dimensional vector with components of type T.
+type Vec4[T Float] struct {
+	X T
+	Y T
+	Z T
+	W T
+}
+
+// IsNaN returns true if any component is NaN.
+func (v Vec4[T]) IsNaN() bool {
+	return math.IsNaN(float64(v.X)) || math.IsNaN(float64(v.Y)) || math.IsNaN(float64(v.Z)) || math.IsNaN(float64(v.W))
+}
+
+// IsInf returns true if any component is Inf.
+func (v Vec4[T]) IsInf() bool {
+	return math.IsInf(float64(v.X), 0) || math.IsInf(float64(v.Y), 0) || math.IsInf(float64(v.Z), 0) || math.IsInf(float64(v.W), 0)
+}
+
+// IsZero returns true if all components are within Epsilon of zero.
+func (v Vec4[T]) IsZero() bool {
+	return eq(v.X, 0.0) && eq(v.Y, 0.0) && eq(v.Z, 0.0) && eq(v.W, 0.0)
+}
+
+// SqrLength returns the squared length of the vector.
+func (v Vec4[T]) SqrLength() T {
+	return Vec4Dot(v, v)
+}
+
+// Length returns the length of the vector.
+func (v Vec4[T]) Length() T {
+	return sqrt(Vec4Dot(v, v))
+}
+
+// VecXYZ returns the X, Y, and Z components as a Vec3.
+func (v Vec4[T]) VecXYZ() Vec3[T] {
+	return NewVec3(v.X, v.Y, v.Z)
+}
+
+// Array returns the vector components as an array.
+func (v Vec4[T]) Array() [4]T {
+	return [4]T{v.X, v.Y, v.Z, v.W}
+}
+
+// String returns a string representation of the vector.
+func (v Vec4[T]) String() string {
+	return fmt.Sprintf("(%f, %f, %f, %f)", v.X, v.Y, v.Z, v.W)
+}
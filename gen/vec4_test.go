@@ -0,0 +1,20 @@
+package gen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/gen"
+)
+
+var _ = Describe("Vec4", func() {
+	Specify("Vec4Dot", func() {
+		Expect(Vec4Dot(NewVec4[float32](1.0, 2.0, 3.0, 4.0), NewVec4[float32](2.0, 0.5, -1.0, 1.0))).To(Equal(float32(4.0)))
+		Expect(Vec4Dot(NewVec4(1.0, 2.0, 3.0, 4.0), NewVec4(2.0, 0.5, -1.0, 1.0))).To(Equal(4.0))
+	})
+
+	Specify("#VecXYZ", func() {
+		Expect(NewVec4[float32](1.0, 2.0, 3.0, 4.0).VecXYZ()).To(Equal(NewVec3[float32](1.0, 2.0, 3.0)))
+		Expect(NewVec4(1.0, 2.0, 3.0, 4.0).VecXYZ()).To(Equal(NewVec3(1.0, 2.0, 3.0)))
+	})
+})
@@ -0,0 +1,143 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Batch", func() {
+	var (
+		mat  Mat4
+		quat Quat
+	)
+
+	vec3Source := func(count int) []Vec3 {
+		result := make([]Vec3, count)
+		for i := range result {
+			result[i] = NewVec3(float32(i%7)-3.0, float32(i%5)+1.0, float32(i%3)-1.0)
+		}
+		return result
+	}
+
+	vec4Source := func(count int) []Vec4 {
+		result := make([]Vec4, count)
+		for i := range result {
+			result[i] = NewVec4(float32(i%7)-3.0, float32(i%5)+1.0, float32(i%3)-1.0, float32(i%2))
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		mat = Mat4MultiProd(
+			TranslationMat4(1.0, 2.0, 3.0),
+			RotationMat4(Degrees(30.0), 0.0, 1.0, 0.0),
+			ScaleMat4(1.0, 2.0, 4.0),
+		)
+		quat = RotationQuat(Degrees(60.0), UnitVec3(NewVec3(1.0, 1.0, 0.0)))
+	})
+
+	DescribeTable("Mat4Vec4ProdBatch",
+		func(count, workers int) {
+			src := vec4Source(count)
+			dst := make([]Vec4, count)
+			Mat4Vec4ProdBatch(dst, src, mat, workers)
+			for i := range src {
+				expected := Mat4Vec4Prod(mat, src[i])
+				Expect(dst[i]).To(HaveVec4Coords(expected.X, expected.Y, expected.Z, expected.W))
+			}
+		},
+		Entry("empty", 0, 1),
+		Entry("sequential", 100, 1),
+		Entry("parallel", 3*MinBatchChunkSize+7, 4),
+	)
+
+	DescribeTable("Mat4Vec3TransformationBatch",
+		func(count, workers int) {
+			src := vec3Source(count)
+			dst := make([]Vec3, count)
+			Mat4Vec3TransformationBatch(dst, src, mat, workers)
+			for i := range src {
+				expected := Mat4Vec3Transformation(mat, src[i])
+				Expect(dst[i]).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+			}
+		},
+		Entry("empty", 0, 1),
+		Entry("sequential", 100, 1),
+		Entry("parallel", 3*MinBatchChunkSize+7, 4),
+	)
+
+	DescribeTable("Mat4Vec3DirectionBatch",
+		func(count, workers int) {
+			src := vec3Source(count)
+			dst := make([]Vec3, count)
+			Mat4Vec3DirectionBatch(dst, src, mat, workers)
+			for i := range src {
+				expected := Mat4Vec4Prod(mat, NewVec4(src[i].X, src[i].Y, src[i].Z, 0.0))
+				Expect(dst[i]).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+			}
+		},
+		Entry("empty", 0, 1),
+		Entry("sequential", 100, 1),
+		Entry("parallel", 3*MinBatchChunkSize+7, 4),
+	)
+
+	DescribeTable("Mat4Vec3NormalBatch",
+		func(count, workers int) {
+			src := vec3Source(count)
+			dst := make([]Vec3, count)
+			Mat4Vec3NormalBatch(dst, src, mat, workers)
+			for i := range src {
+				expected := UnitVec3(Mat3Vec3Prod(NormalMat3(mat), src[i]))
+				Expect(dst[i]).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+			}
+		},
+		Entry("empty", 0, 1),
+		Entry("sequential", 100, 1),
+		Entry("parallel", 3*MinBatchChunkSize+7, 4),
+	)
+
+	DescribeTable("QuatVec3RotationBatch",
+		func(count, workers int) {
+			src := vec3Source(count)
+			dst := make([]Vec3, count)
+			QuatVec3RotationBatch(dst, src, quat, workers)
+			for i := range src {
+				expected := QuatVec3Rotation(quat, src[i])
+				Expect(dst[i]).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+			}
+		},
+		Entry("empty", 0, 1),
+		Entry("sequential", 100, 1),
+		Entry("parallel", 3*MinBatchChunkSize+7, 4),
+	)
+
+	It("supports transforming in place", func() {
+		points := vec3Source(3)
+		Mat4Vec3TransformationBatch(points, points, TranslationMat4(1.0, 2.0, 3.0), 1)
+		Expect(points[0]).To(HaveVec3Coords(-2.0, 3.0, 2.0))
+		Expect(points[1]).To(HaveVec3Coords(-1.0, 4.0, 3.0))
+		Expect(points[2]).To(HaveVec3Coords(0.0, 5.0, 4.0))
+	})
+
+	It("panics if the destination is too short", func() {
+		Expect(func() {
+			Mat4Vec3TransformationBatch(make([]Vec3, 2), vec3Source(3), mat, 1)
+		}).To(Panic())
+	})
+
+	It("panics if the destination is too short despite its capacity", func() {
+		message := "batch destination has 2 elements but source has 3"
+		Expect(func() {
+			Mat4Vec4ProdBatch(make([]Vec4, 2, 8), vec4Source(3), mat, 1)
+		}).To(PanicWith(message))
+		Expect(func() {
+			Mat4Vec3TransformationBatch(make([]Vec3, 2, 8), vec3Source(3), mat, 1)
+		}).To(PanicWith(message))
+		Expect(func() {
+			QuatVec3RotationBatch(make([]Vec3, 2, 8), vec3Source(3), quat, 1)
+		}).To(PanicWith(message))
+	})
+})
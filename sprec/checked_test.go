@@ -0,0 +1,105 @@
+package sprec_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Checked", func() {
+	var (
+		singularErr *SingularMatrixError
+		lengthErr   *ZeroLengthError
+	)
+
+	Specify("CheckedInverseMat2", func() {
+		result, err := CheckedInverseMat2(ScaleMat2(2.0, 4.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveMat2Elements(
+			0.5, 0.0,
+			0.0, 0.25,
+		))
+
+		_, err = CheckedInverseMat2(NewMat2(1.0, 2.0, 2.0, 4.0), Epsilon)
+		Expect(errors.As(err, &singularErr)).To(BeTrue())
+		Expect(singularErr.Determinant).To(EqualFloat32(0.0))
+		Expect(singularErr.Threshold).To(EqualFloat32(Epsilon))
+	})
+
+	Specify("CheckedInverseMat3", func() {
+		result, err := CheckedInverseMat3(DiagonalMat3(2.0, 4.0, 5.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveMat3Elements(
+			0.5, 0.0, 0.0,
+			0.0, 0.25, 0.0,
+			0.0, 0.0, 0.2,
+		))
+
+		_, err = CheckedInverseMat3(DiagonalMat3(0.01, 0.01, 0.01), 0.001)
+		Expect(errors.As(err, &singularErr)).To(BeTrue())
+		Expect(singularErr.Determinant).To(EqualFloat32(0.000001))
+		Expect(singularErr.Threshold).To(EqualFloat32(0.001))
+	})
+
+	Specify("CheckedInverseMat4", func() {
+		result, err := CheckedInverseMat4(ScaleMat4(2.0, 4.0, 5.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveMat4Elements(
+			0.5, 0.0, 0.0, 0.0,
+			0.0, 0.25, 0.0, 0.0,
+			0.0, 0.0, 0.2, 0.0,
+			0.0, 0.0, 0.0, 1.0,
+		))
+
+		_, err = CheckedInverseMat4(ScaleMat4(2.0, 4.0, 0.0), Epsilon)
+		Expect(errors.As(err, &singularErr)).To(BeTrue())
+		Expect(err).To(MatchError("matrix is singular: determinant 0 is within threshold 1e-06"))
+	})
+
+	Specify("CheckedUnitVec2", func() {
+		result, err := CheckedUnitVec2(NewVec2(3.0, 4.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveVec2Coords(0.6, 0.8))
+
+		_, err = CheckedUnitVec2(ZeroVec2(), Epsilon)
+		Expect(errors.As(err, &lengthErr)).To(BeTrue())
+		Expect(lengthErr.Length).To(EqualFloat32(0.0))
+	})
+
+	Specify("CheckedUnitVec3", func() {
+		result, err := CheckedUnitVec3(NewVec3(0.0, 3.0, 4.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveVec3Coords(0.0, 0.6, 0.8))
+
+		_, err = CheckedUnitVec3(NewVec3(0.0, 0.003, 0.004), 0.01)
+		Expect(errors.As(err, &lengthErr)).To(BeTrue())
+		Expect(lengthErr.Length).To(EqualFloat32(0.005))
+		Expect(lengthErr.Threshold).To(EqualFloat32(0.01))
+
+		_, err = CheckedUnitVec3(NewVec3(Sqrt(-1.0), 0.0, 0.0), Epsilon)
+		Expect(errors.As(err, &lengthErr)).To(BeTrue())
+	})
+
+	Specify("CheckedUnitVec4", func() {
+		result, err := CheckedUnitVec4(NewVec4(0.0, 3.0, 0.0, 4.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveVec4Coords(0.0, 0.6, 0.0, 0.8))
+
+		_, err = CheckedUnitVec4(ZeroVec4(), Epsilon)
+		Expect(errors.As(err, &lengthErr)).To(BeTrue())
+		Expect(err).To(MatchError("cannot normalize: length 0 is within threshold 1e-06"))
+	})
+
+	Specify("CheckedUnitQuat", func() {
+		result, err := CheckedUnitQuat(NewQuat(3.0, 0.0, 4.0, 0.0), Epsilon)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveQuatCoords(0.6, 0.0, 0.8, 0.0))
+
+		_, err = CheckedUnitQuat(Quat{}, Epsilon)
+		Expect(errors.As(err, &lengthErr)).To(BeTrue())
+	})
+})
@@ -0,0 +1,189 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("DualQuat", func() {
+	var (
+		rotation    Quat
+		translation Vec3
+		transform   DualQuat
+	)
+
+	BeforeEach(func() {
+		rotation = RotationQuat(Degrees(90.0), BasisYVec3())
+		translation = NewVec3(1.0, 2.0, 3.0)
+		transform = RotationTranslationDualQuat(rotation, translation)
+	})
+
+	Specify("NewDualQuat", func() {
+		result := NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0))
+		Expect(result).To(HaveDualQuatCoords(1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0))
+	})
+
+	Specify("IdentityDualQuat", func() {
+		result := IdentityDualQuat()
+		Expect(result).To(HaveDualQuatCoords(1.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0))
+		Expect(result.IsIdentity()).To(BeTrue())
+	})
+
+	Specify("RotationTranslationDualQuat", func() {
+		Expect(transform).To(HaveDualQuatCoords(
+			0.7071067811865476, 0.0, 0.7071067811865476, 0.0,
+			-0.7071067811865476, -0.7071067811865476, 0.7071067811865476, 1.4142135623730951,
+		))
+	})
+
+	Specify("TranslationDualQuat", func() {
+		result := TranslationDualQuat(NewVec3(2.0, 4.0, 6.0))
+		Expect(result).To(HaveDualQuatCoords(1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 2.0, 3.0))
+	})
+
+	Specify("Mat4ToDualQuat", func() {
+		result := Mat4ToDualQuat(TRSMat4(translation, rotation, NewVec3(1.0, 1.0, 1.0)))
+		Expect(result.Rotation()).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.7071067811865476, 0.0))
+		Expect(result.Translation()).To(HaveVec3Coords(1.0, 2.0, 3.0))
+	})
+
+	Specify("DualQuatSum", func() {
+		result := DualQuatSum(
+			NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0)),
+			NewDualQuat(NewQuat(0.5, 0.5, 0.5, 0.5), NewQuat(-1.0, -1.0, -1.0, -1.0)),
+		)
+		Expect(result).To(HaveDualQuatCoords(1.5, 2.5, 3.5, 4.5, 4.0, 5.0, 6.0, 7.0))
+	})
+
+	Specify("DualQuatScalarProd", func() {
+		result := DualQuatScalarProd(NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0)), 2.0)
+		Expect(result).To(HaveDualQuatCoords(2.0, 4.0, 6.0, 8.0, 10.0, 12.0, 14.0, 16.0))
+	})
+
+	Specify("DualQuatProd", func() {
+		move := TranslationDualQuat(NewVec3(1.0, 0.0, 0.0))
+		turn := RotationTranslationDualQuat(rotation, ZeroVec3())
+		point := NewVec3(1.0, 0.0, 0.0)
+		Expect(DualQuatVec3Transformation(DualQuatProd(move, turn), point)).To(HaveVec3Coords(1.0, 0.0, -1.0))
+		Expect(DualQuatVec3Transformation(DualQuatProd(turn, move), point)).To(HaveVec3Coords(0.0, 0.0, -2.0))
+	})
+
+	Specify("ConjugateDualQuat", func() {
+		result := ConjugateDualQuat(NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0)))
+		Expect(result).To(HaveDualQuatCoords(1.0, -2.0, -3.0, -4.0, 5.0, -6.0, -7.0, -8.0))
+	})
+
+	Specify("DualConjugateDualQuat", func() {
+		result := DualConjugateDualQuat(NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0)))
+		Expect(result).To(HaveDualQuatCoords(1.0, 2.0, 3.0, 4.0, -5.0, -6.0, -7.0, -8.0))
+	})
+
+	Specify("CombinedConjugateDualQuat", func() {
+		result := CombinedConjugateDualQuat(NewDualQuat(NewQuat(1.0, 2.0, 3.0, 4.0), NewQuat(5.0, 6.0, 7.0, 8.0)))
+		Expect(result).To(HaveDualQuatCoords(1.0, -2.0, -3.0, -4.0, -5.0, 6.0, 7.0, 8.0))
+	})
+
+	Specify("InverseDualQuat", func() {
+		inverse := InverseDualQuat(transform)
+		Expect(DualQuatProd(transform, inverse).IsIdentity()).To(BeTrue())
+		Expect(DualQuatProd(inverse, transform).IsIdentity()).To(BeTrue())
+		Expect(DualQuatVec3Transformation(inverse, NewVec3(1.0, 2.0, 2.0))).To(HaveVec3Coords(1.0, 0.0, 0.0))
+	})
+
+	Specify("UnitDualQuat", func() {
+		scaled := DualQuatScalarProd(transform, 3.0)
+		result := UnitDualQuat(scaled)
+		Expect(result.Rotation()).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.7071067811865476, 0.0))
+		Expect(result.Translation()).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(QuatDot(result.Real, result.Dual)).To(EqualFloat32(0.0))
+	})
+
+	Specify("DualQuatVec3Transformation", func() {
+		result := DualQuatVec3Transformation(transform, NewVec3(1.0, 0.0, 0.0))
+		Expect(result).To(HaveVec3Coords(1.0, 2.0, 2.0))
+	})
+
+	Describe("DualQuatScLerp", func() {
+		var target DualQuat
+
+		BeforeEach(func() {
+			target = RotationTranslationDualQuat(RotationQuat(Degrees(90.0), BasisZVec3()), NewVec3(0.0, 0.0, 2.0))
+		})
+
+		Specify("end points", func() {
+			point := NewVec3(1.0, 1.0, 1.0)
+			start := DualQuatScLerp(transform, target, 0.0)
+			Expect(DualQuatVec3Transformation(start, point)).To(HaveVec3Coords(2.0, 3.0, 2.0))
+			end := DualQuatScLerp(transform, target, 1.0)
+			Expect(DualQuatVec3Transformation(end, point)).To(HaveVec3Coords(-1.0, 1.0, 3.0))
+		})
+
+		Specify("screw motion", func() {
+			result := DualQuatScLerp(IdentityDualQuat(), target, 0.5)
+			Expect(result.Rotation()).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+			Expect(result.Translation()).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("screw motion with offset axis", func() {
+			// This is a rotation by 90 degrees around the vertical axis that
+			// passes through (1, 0, 0), so the origin moves along an arc.
+			target = RotationTranslationDualQuat(RotationQuat(Degrees(90.0), BasisYVec3()), NewVec3(1.0, 0.0, 1.0))
+			result := DualQuatScLerp(IdentityDualQuat(), target, 0.5)
+			Expect(DualQuatVec3Transformation(result, ZeroVec3())).To(HaveVec3Coords(0.2928932188134524, 0.0, 0.7071067811865476))
+			Expect(DualQuatVec3Transformation(result, NewVec3(1.0, 5.0, 0.0))).To(HaveVec3Coords(1.0, 5.0, 0.0))
+		})
+
+		Specify("pure translation", func() {
+			target = TranslationDualQuat(NewVec3(4.0, 0.0, 0.0))
+			result := DualQuatScLerp(IdentityDualQuat(), target, 0.25)
+			Expect(result).To(HaveDualQuatCoords(1.0, 0.0, 0.0, 0.0, 0.0, 0.5, 0.0, 0.0))
+		})
+
+		Specify("shortest path", func() {
+			result := DualQuatScLerp(IdentityDualQuat(), DualQuatScalarProd(target, -1.0), 0.5)
+			Expect(result.Rotation()).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+			Expect(result.Translation()).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+	})
+
+	Specify("DualQuatBlend", func() {
+		first := TranslationDualQuat(NewVec3(2.0, 0.0, 0.0))
+		second := TranslationDualQuat(NewVec3(0.0, 2.0, 0.0))
+		result := DualQuatBlend([]DualQuat{first, DualQuatScalarProd(second, -1.0)}, []float32{0.5, 0.5})
+		Expect(result.Rotation()).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		Expect(result.Translation()).To(HaveVec3Coords(1.0, 1.0, 0.0))
+
+		result = DualQuatBlend([]DualQuat{IdentityDualQuat(), transform}, []float32{0.0, 1.0})
+		Expect(DualQuatVec3Transformation(result, NewVec3(1.0, 0.0, 0.0))).To(HaveVec3Coords(1.0, 2.0, 2.0))
+	})
+
+	Specify("#IsNaN", func() {
+		Expect(transform.IsNaN()).To(BeFalse())
+		Expect(NewDualQuat(IdentityQuat(), NewQuat(0.0, Sqrt(-1.0), 0.0, 0.0)).IsNaN()).To(BeTrue())
+	})
+
+	Specify("#IsIdentity", func() {
+		Expect(transform.IsIdentity()).To(BeFalse())
+		Expect(TranslationDualQuat(NewVec3(0.0, 1.0, 0.0)).IsIdentity()).To(BeFalse())
+	})
+
+	Specify("#Mat4", func() {
+		matrix := transform.Mat4()
+		Expect(Mat4Vec3Transformation(matrix, NewVec3(1.0, 0.0, 0.0))).To(HaveVec3Coords(1.0, 2.0, 2.0))
+		Expect(matrix.Translation()).To(HaveVec3Coords(1.0, 2.0, 3.0))
+	})
+
+	Specify("#TRS", func() {
+		t, r, s := transform.TRS()
+		Expect(t).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(r).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.7071067811865476, 0.0))
+		Expect(s).To(HaveVec3Coords(1.0, 1.0, 1.0))
+	})
+
+	Specify("#String", func() {
+		Expect(IdentityDualQuat().String()).To(Equal("((1.000000, 0.000000, 0.000000, 0.000000), (0.000000, 0.000000, 0.000000, 0.000000))"))
+	})
+})
@@ -0,0 +1,91 @@
+package sprec_test
+
+import (
+	"fmt"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+)
+
+var _ = Describe("Format", func() {
+	vec := NewVec3(1.0, 2.5, -3.0)
+
+	DescribeTable("Vec3",
+		func(format, expected string) {
+			Expect(fmt.Sprintf(format, vec)).To(Equal(expected))
+		},
+		Entry("%v", "%v", "(1.000000, 2.500000, -3.000000)"),
+		Entry("%s", "%s", "(1.000000, 2.500000, -3.000000)"),
+		Entry("%.3f", "%.3f", "(1.000, 2.500, -3.000)"),
+		Entry("%g", "%g", "(1, 2.5, -3)"),
+		Entry("%.2e", "%.2e", "(1.00e+00, 2.50e+00, -3.00e+00)"),
+		Entry("%7.2f", "%7.2f", "(   1.00,    2.50,   -3.00)"),
+		Entry("%-6g|", "%-6g|", "(1     , 2.5   , -3    )|"),
+		Entry("%+v", "%+v", "(X:1.000000 Y:2.500000 Z:-3.000000)"),
+		Entry("%+.1f", "%+.1f", "(X:1.0 Y:2.5 Z:-3.0)"),
+		Entry("%#v", "%#v", "sprec.Vec3{X:1, Y:2.5, Z:-3}"),
+		Entry("%d", "%d", "%!d(sprec.Vec3=(1.000000, 2.500000, -3.000000))"),
+	)
+
+	Specify("Vec2", func() {
+		Expect(fmt.Sprintf("%.1f", NewVec2(1.0, 2.0))).To(Equal("(1.0, 2.0)"))
+		Expect(fmt.Sprintf("%+g", NewVec2(1.0, 2.0))).To(Equal("(X:1 Y:2)"))
+		Expect(fmt.Sprintf("%#v", NewVec2(0.1, 2.0))).To(Equal("sprec.Vec2{X:0.1, Y:2}"))
+	})
+
+	Specify("Vec4", func() {
+		Expect(fmt.Sprintf("%g", NewVec4(1.0, 2.0, 3.0, 4.0))).To(Equal("(1, 2, 3, 4)"))
+		Expect(fmt.Sprintf("%+g", NewVec4(1.0, 2.0, 3.0, 4.0))).To(Equal("(X:1 Y:2 Z:3 W:4)"))
+		Expect(fmt.Sprintf("%#v", NewVec4(1.0, 2.0, 3.0, 4.0))).To(Equal("sprec.Vec4{X:1, Y:2, Z:3, W:4}"))
+	})
+
+	Specify("Quat", func() {
+		quat := NewQuat(1.0, 2.0, 3.0, 4.0)
+		Expect(fmt.Sprintf("%v", quat)).To(Equal(quat.String()))
+		Expect(fmt.Sprintf("%+g", quat)).To(Equal("(W:1 X:2 Y:3 Z:4)"))
+		Expect(fmt.Sprintf("%#v", quat)).To(Equal("sprec.Quat{W:1, X:2, Y:3, Z:4}"))
+	})
+
+	Specify("Mat2", func() {
+		matrix := NewMat2(
+			1.0, 2.0,
+			3.0, 4.0,
+		)
+		Expect(fmt.Sprintf("%v", matrix)).To(Equal(matrix.String()))
+		Expect(fmt.Sprintf("%g", matrix)).To(Equal("((1, 2), (3, 4))"))
+		Expect(fmt.Sprintf("%+g", matrix)).To(Equal("((M11:1 M12:2) (M21:3 M22:4))"))
+		Expect(fmt.Sprintf("%#v", matrix)).To(Equal("sprec.Mat2{M11:1, M12:2, M21:3, M22:4}"))
+	})
+
+	Specify("Mat3", func() {
+		matrix := NewMat3(
+			1.0, 2.0, 3.0,
+			4.0, 5.0, 6.0,
+			7.0, 8.0, 9.0,
+		)
+		Expect(fmt.Sprintf("%v", matrix)).To(Equal(matrix.String()))
+		Expect(fmt.Sprintf("%g", matrix)).To(Equal("((1, 2, 3), (4, 5, 6), (7, 8, 9))"))
+		Expect(fmt.Sprintf("%+g", matrix)).To(Equal("((M11:1 M12:2 M13:3) (M21:4 M22:5 M23:6) (M31:7 M32:8 M33:9))"))
+		Expect(fmt.Sprintf("%#v", matrix)).To(Equal("sprec.Mat3{M11:1, M12:2, M13:3, M21:4, M22:5, M23:6, M31:7, M32:8, M33:9}"))
+	})
+
+	Specify("Mat4", func() {
+		matrix := TranslationMat4(1.0, 2.0, 3.0)
+		Expect(fmt.Sprintf("%v", matrix)).To(Equal(matrix.String()))
+		Expect(fmt.Sprintf("%.0f", matrix)).To(Equal("((1, 0, 0, 1), (0, 1, 0, 2), (0, 0, 1, 3), (0, 0, 0, 1))"))
+		Expect(fmt.Sprintf("%#v", matrix)).To(Equal("sprec.Mat4{M11:1, M12:0, M13:0, M14:1, M21:0, M22:1, M23:0, M24:2, M31:0, M32:0, M33:1, M34:3, M41:0, M42:0, M43:0, M44:1}"))
+	})
+
+	Specify("Go-syntax form with non-finite values", func() {
+		value := NewVec2(float32(math.NaN()), float32(math.Inf(-1)))
+		Expect(fmt.Sprintf("%#v", value)).To(Equal("sprec.Vec2{X:float32(math.NaN()), Y:float32(math.Inf(-1))}"))
+	})
+
+	Specify("nested in other values", func() {
+		Expect(fmt.Sprintf("%.1f", []Vec2{NewVec2(1.0, 2.0), NewVec2(3.0, 4.0)})).To(Equal("[(1.0, 2.0) (3.0, 4.0)]"))
+		Expect(fmt.Sprint(NewRay(NewVec3(0.0, 0.0, 0.0), NewVec3(0.0, 0.0, -1.0)))).To(ContainSubstring("-1.000000"))
+	})
+})
@@ -0,0 +1,156 @@
+package sprec_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Frustum", func() {
+	var (
+		projection Mat4
+		frustum    Frustum
+	)
+
+	BeforeEach(func() {
+		projection = PerspectiveMat4(-1.0, 1.0, -1.0, 1.0, 1.0, 3.0)
+		frustum = ProjectionFrustum(projection, DepthMapping{})
+	})
+
+	Describe("ProjectionFrustum", func() {
+		Specify("perspective projection", func() {
+			Expect(frustum.Left).To(HavePlaneElements(0.7071067811865476, 0.0, -0.7071067811865476, 0.0))
+			Expect(frustum.Right).To(HavePlaneElements(-0.7071067811865476, 0.0, -0.7071067811865476, 0.0))
+			Expect(frustum.Bottom).To(HavePlaneElements(0.0, 0.7071067811865476, -0.7071067811865476, 0.0))
+			Expect(frustum.Top).To(HavePlaneElements(0.0, -0.7071067811865476, -0.7071067811865476, 0.0))
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, 1.0))
+			Expect(frustum.Far).To(HavePlaneElements(0.0, 0.0, 1.0, -3.0))
+		})
+
+		Specify("orthographic projection", func() {
+			projection = OrthoMat4(-2.0, 2.0, 1.0, -1.0, 1.0, 3.0)
+			frustum = ProjectionFrustum(projection, DepthMapping{})
+			Expect(frustum.Left).To(HavePlaneElements(1.0, 0.0, 0.0, -2.0))
+			Expect(frustum.Right).To(HavePlaneElements(-1.0, 0.0, 0.0, -2.0))
+			Expect(frustum.Bottom).To(HavePlaneElements(0.0, 1.0, 0.0, -1.0))
+			Expect(frustum.Top).To(HavePlaneElements(0.0, -1.0, 0.0, -1.0))
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, 1.0))
+			Expect(frustum.Far).To(HavePlaneElements(0.0, 0.0, 1.0, -3.0))
+		})
+
+		Specify("zero to one depth range", func() {
+			depthRemap := NewMat4(
+				1.0, 0.0, 0.0, 0.0,
+				0.0, 1.0, 0.0, 0.0,
+				0.0, 0.0, 0.5, 0.5,
+				0.0, 0.0, 0.0, 1.0,
+			)
+			projection = Mat4Prod(depthRemap, projection)
+			frustum = ProjectionFrustum(projection, DepthMapping{Range: DepthRangeZeroToOne})
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, 1.0))
+			Expect(frustum.Far).To(HavePlaneElements(0.0, 0.0, 1.0, -3.0))
+		})
+
+		Specify("reversed depth", func() {
+			mapping := DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+			projection = PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, 3.0, mapping)
+			frustum = ProjectionFrustum(projection, mapping)
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, 1.0))
+			Expect(frustum.Far).To(HavePlaneElements(0.0, 0.0, 1.0, -3.0))
+		})
+
+		Specify("infinite far plane", func() {
+			mapping := DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+			projection = PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, float32(math.Inf(1)), mapping)
+			frustum = ProjectionFrustum(projection, mapping)
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, 1.0))
+			Expect(frustum.ContainsPoint(NewVec3(0.0, 0.0, -1000.0))).To(BeTrue())
+			Expect(frustum.ContainsPoint(NewVec3(0.0, 0.0, -0.5))).To(BeFalse())
+		})
+
+		Specify("view-projection", func() {
+			view := TranslationMat4(0.0, 0.0, -5.0)
+			frustum = ProjectionFrustum(Mat4Prod(projection, view), DepthMapping{})
+			Expect(frustum.Near).To(HavePlaneElements(0.0, 0.0, -1.0, -4.0))
+			Expect(frustum.Far).To(HavePlaneElements(0.0, 0.0, 1.0, 2.0))
+			Expect(frustum.ContainsPoint(NewVec3(0.0, 0.0, 3.0))).To(BeTrue())
+			Expect(frustum.ContainsPoint(NewVec3(0.0, 0.0, -3.0))).To(BeFalse())
+		})
+	})
+
+	Specify("#Planes", func() {
+		planes := frustum.Planes()
+		Expect(planes[0]).To(Equal(frustum.Left))
+		Expect(planes[1]).To(Equal(frustum.Right))
+		Expect(planes[2]).To(Equal(frustum.Bottom))
+		Expect(planes[3]).To(Equal(frustum.Top))
+		Expect(planes[4]).To(Equal(frustum.Near))
+		Expect(planes[5]).To(Equal(frustum.Far))
+	})
+
+	Specify("#Corners", func() {
+		corners, ok := frustum.Corners()
+		Expect(ok).To(BeTrue())
+		Expect(corners[0]).To(HaveVec3Coords(-1.0, -1.0, -1.0))
+		Expect(corners[1]).To(HaveVec3Coords(1.0, -1.0, -1.0))
+		Expect(corners[2]).To(HaveVec3Coords(-1.0, 1.0, -1.0))
+		Expect(corners[3]).To(HaveVec3Coords(1.0, 1.0, -1.0))
+		Expect(corners[4]).To(HaveVec3Coords(-3.0, -3.0, -3.0))
+		Expect(corners[5]).To(HaveVec3Coords(3.0, -3.0, -3.0))
+		Expect(corners[6]).To(HaveVec3Coords(-3.0, 3.0, -3.0))
+		Expect(corners[7]).To(HaveVec3Coords(3.0, 3.0, -3.0))
+	})
+
+	Specify("#Corners with infinite far plane", func() {
+		mapping := DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+		projection = PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, float32(math.Inf(1)), mapping)
+		frustum = ProjectionFrustum(projection, mapping)
+		corners, ok := frustum.Corners()
+		Expect(ok).To(BeFalse())
+		Expect(corners[0]).To(HaveVec3Coords(-1.0, -1.0, -1.0))
+		Expect(corners[3]).To(HaveVec3Coords(1.0, 1.0, -1.0))
+	})
+
+	DescribeTable("#ContainsPoint",
+		func(point Vec3, expected bool) {
+			Expect(frustum.ContainsPoint(point)).To(Equal(expected))
+		},
+		Entry("center", NewVec3(0.0, 0.0, -2.0), true),
+		Entry("on boundary", NewVec3(2.0, 0.0, -2.0), true),
+		Entry("before near", NewVec3(0.0, 0.0, -0.5), false),
+		Entry("beyond far", NewVec3(0.0, 0.0, -3.5), false),
+		Entry("left", NewVec3(-2.5, 0.0, -2.0), false),
+		Entry("right", NewVec3(2.5, 0.0, -2.0), false),
+		Entry("bottom", NewVec3(0.0, -2.5, -2.0), false),
+		Entry("top", NewVec3(0.0, 2.5, -2.0), false),
+		Entry("behind", NewVec3(0.0, 0.0, 2.0), false),
+	)
+
+	DescribeTable("#ClassifySphere",
+		func(center Vec3, radius float32, expected Containment) {
+			Expect(frustum.ClassifySphere(center, radius)).To(Equal(expected))
+		},
+		Entry("inside", NewVec3(0.0, 0.0, -2.0), float32(0.5), ContainmentInside),
+		Entry("crossing near", NewVec3(0.0, 0.0, -1.0), float32(0.5), ContainmentIntersecting),
+		Entry("crossing side", NewVec3(2.0, 0.0, -2.0), float32(0.5), ContainmentIntersecting),
+		Entry("enclosing", NewVec3(0.0, 0.0, -2.0), float32(10.0), ContainmentIntersecting),
+		Entry("outside near", NewVec3(0.0, 0.0, 0.0), float32(0.5), ContainmentOutside),
+		Entry("outside side", NewVec3(4.0, 0.0, -2.0), float32(0.5), ContainmentOutside),
+	)
+
+	DescribeTable("#ClassifyAABB",
+		func(box AABB, expected Containment) {
+			Expect(frustum.ClassifyAABB(box)).To(Equal(expected))
+		},
+		Entry("inside", NewAABB(NewVec3(-0.5, -0.5, -2.5), NewVec3(0.5, 0.5, -1.5)), ContainmentInside),
+		Entry("crossing near", NewAABB(NewVec3(-0.5, -0.5, -1.5), NewVec3(0.5, 0.5, -0.5)), ContainmentIntersecting),
+		Entry("crossing side", NewAABB(NewVec3(1.5, -0.5, -2.5), NewVec3(2.5, 0.5, -1.5)), ContainmentIntersecting),
+		Entry("enclosing", NewAABB(NewVec3(-10.0, -10.0, -10.0), NewVec3(10.0, 10.0, 10.0)), ContainmentIntersecting),
+		Entry("outside near", NewAABB(NewVec3(-0.5, -0.5, -0.5), NewVec3(0.5, 0.5, 0.5)), ContainmentOutside),
+		Entry("outside side", NewAABB(NewVec3(3.5, -0.5, -2.5), NewVec3(4.5, 0.5, -1.5)), ContainmentOutside),
+	)
+})
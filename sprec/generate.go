@@ -0,0 +1,3 @@
+package sprec
+
+//go:generate go run ../cmd/precgen -src ../dprec -dst .
@@ -0,0 +1,127 @@
+package sprec_test
+
+import (
+	"testing"
+
+	. "github.com/mokiat/gomath/sprec"
+)
+
+func BenchmarkVec3SumProd(b *testing.B) {
+	result := ZeroVec3()
+	other := NewVec3(1.0, 2.0, 3.0)
+	for b.Loop() {
+		result = Vec3Sum(result, Vec3Prod(other, 0.5))
+	}
+	_ = result
+}
+
+func BenchmarkVec3AddScaled(b *testing.B) {
+	result := ZeroVec3()
+	other := NewVec3(1.0, 2.0, 3.0)
+	for b.Loop() {
+		result.AddScaled(other, 0.5)
+	}
+	_ = result
+}
+
+func BenchmarkUnitVec3(b *testing.B) {
+	result := NewVec3(1.0, 2.0, 3.0)
+	for b.Loop() {
+		result = UnitVec3(result)
+	}
+	_ = result
+}
+
+func BenchmarkVec3Normalize(b *testing.B) {
+	result := NewVec3(1.0, 2.0, 3.0)
+	for b.Loop() {
+		result.Normalize()
+	}
+	_ = result
+}
+
+func BenchmarkQuatProd(b *testing.B) {
+	result := IdentityQuat()
+	other := RotationQuat(Degrees(1.0), BasisYVec3())
+	for b.Loop() {
+		result = QuatProd(result, other)
+	}
+	_ = result
+}
+
+func BenchmarkQuatMul(b *testing.B) {
+	result := IdentityQuat()
+	other := RotationQuat(Degrees(1.0), BasisYVec3())
+	for b.Loop() {
+		result.Mul(other)
+	}
+	_ = result
+}
+
+func BenchmarkUnitQuat(b *testing.B) {
+	result := NewQuat(1.0, 2.0, 3.0, 4.0)
+	for b.Loop() {
+		result = UnitQuat(result)
+	}
+	_ = result
+}
+
+func BenchmarkQuatNormalize(b *testing.B) {
+	result := NewQuat(1.0, 2.0, 3.0, 4.0)
+	for b.Loop() {
+		result.Normalize()
+	}
+	_ = result
+}
+
+func BenchmarkMat3Prod(b *testing.B) {
+	result := IdentityMat3()
+	other := RotationMat3(Degrees(1.0))
+	for b.Loop() {
+		result = Mat3Prod(result, other)
+	}
+	_ = result
+}
+
+func BenchmarkMat3Mul(b *testing.B) {
+	result := IdentityMat3()
+	other := RotationMat3(Degrees(1.0))
+	for b.Loop() {
+		result.Mul(&other)
+	}
+	_ = result
+}
+
+func BenchmarkMat4Prod(b *testing.B) {
+	result := IdentityMat4()
+	other := RotationMat4(Degrees(1.0), 0.0, 1.0, 0.0)
+	for b.Loop() {
+		result = Mat4Prod(result, other)
+	}
+	_ = result
+}
+
+func BenchmarkMat4Mul(b *testing.B) {
+	result := IdentityMat4()
+	other := RotationMat4(Degrees(1.0), 0.0, 1.0, 0.0)
+	for b.Loop() {
+		result.Mul(&other)
+	}
+	_ = result
+}
+
+func BenchmarkTransposedMat4(b *testing.B) {
+	result := RotationMat4(Degrees(1.0), 0.0, 1.0, 0.0)
+	for b.Loop() {
+		result = TransposedMat4(result)
+	}
+	_ = result
+}
+
+func BenchmarkMat4Transpose(b *testing.B) {
+	result := RotationMat4(Degrees(1.0), 0.0, 1.0, 0.0)
+	for b.Loop() {
+		result.Transpose()
+	}
+	_ = result
+}
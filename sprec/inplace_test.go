@@ -0,0 +1,270 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("InPlace", func() {
+	Describe("Vec2", func() {
+		var vector Vec2
+
+		BeforeEach(func() {
+			vector = NewVec2(3.0, 4.0)
+		})
+
+		Specify("#Add", func() {
+			vector.Add(NewVec2(1.0, 2.0))
+			Expect(vector).To(HaveVec2Coords(4.0, 6.0))
+		})
+
+		Specify("#Sub", func() {
+			vector.Sub(NewVec2(1.0, 2.0))
+			Expect(vector).To(HaveVec2Coords(2.0, 2.0))
+		})
+
+		Specify("#Scale", func() {
+			vector.Scale(2.0)
+			Expect(vector).To(HaveVec2Coords(6.0, 8.0))
+		})
+
+		Specify("#AddScaled", func() {
+			vector.AddScaled(NewVec2(1.0, 2.0), 0.5)
+			Expect(vector).To(HaveVec2Coords(3.5, 5.0))
+		})
+
+		Specify("#Negate", func() {
+			vector.Negate()
+			Expect(vector).To(HaveVec2Coords(-3.0, -4.0))
+		})
+
+		Specify("#Normalize", func() {
+			vector.Normalize()
+			Expect(vector).To(HaveVec2Coords(0.6, 0.8))
+		})
+	})
+
+	Describe("Vec3", func() {
+		var vector Vec3
+
+		BeforeEach(func() {
+			vector = NewVec3(2.0, 3.0, 6.0)
+		})
+
+		Specify("#Add", func() {
+			vector.Add(NewVec3(1.0, 2.0, 3.0))
+			Expect(vector).To(HaveVec3Coords(3.0, 5.0, 9.0))
+		})
+
+		Specify("#Sub", func() {
+			vector.Sub(NewVec3(1.0, 2.0, 3.0))
+			Expect(vector).To(HaveVec3Coords(1.0, 1.0, 3.0))
+		})
+
+		Specify("#Scale", func() {
+			vector.Scale(2.0)
+			Expect(vector).To(HaveVec3Coords(4.0, 6.0, 12.0))
+		})
+
+		Specify("#AddScaled", func() {
+			vector.AddScaled(NewVec3(1.0, 2.0, 3.0), 2.0)
+			Expect(vector).To(HaveVec3Coords(4.0, 7.0, 12.0))
+			Expect(vector).To(Equal(Vec3Sum(NewVec3(2.0, 3.0, 6.0), Vec3Prod(NewVec3(1.0, 2.0, 3.0), 2.0))))
+		})
+
+		Specify("#Negate", func() {
+			vector.Negate()
+			Expect(vector).To(HaveVec3Coords(-2.0, -3.0, -6.0))
+		})
+
+		Specify("#Normalize", func() {
+			vector.Normalize()
+			Expect(vector).To(HaveVec3Coords(2.0/7.0, 3.0/7.0, 6.0/7.0))
+		})
+	})
+
+	Describe("Vec4", func() {
+		var vector Vec4
+
+		BeforeEach(func() {
+			vector = NewVec4(1.0, 2.0, 2.0, 4.0)
+		})
+
+		Specify("#Add", func() {
+			vector.Add(NewVec4(1.0, 2.0, 3.0, 4.0))
+			Expect(vector).To(HaveVec4Coords(2.0, 4.0, 5.0, 8.0))
+		})
+
+		Specify("#Sub", func() {
+			vector.Sub(NewVec4(1.0, 2.0, 3.0, 4.0))
+			Expect(vector).To(HaveVec4Coords(0.0, 0.0, -1.0, 0.0))
+		})
+
+		Specify("#Scale", func() {
+			vector.Scale(0.5)
+			Expect(vector).To(HaveVec4Coords(0.5, 1.0, 1.0, 2.0))
+		})
+
+		Specify("#AddScaled", func() {
+			vector.AddScaled(NewVec4(1.0, 2.0, 3.0, 4.0), -1.0)
+			Expect(vector).To(HaveVec4Coords(0.0, 0.0, -1.0, 0.0))
+		})
+
+		Specify("#Negate", func() {
+			vector.Negate()
+			Expect(vector).To(HaveVec4Coords(-1.0, -2.0, -2.0, -4.0))
+		})
+
+		Specify("#Normalize", func() {
+			vector.Normalize()
+			Expect(vector).To(HaveVec4Coords(0.2, 0.4, 0.4, 0.8))
+		})
+	})
+
+	Describe("Quat", func() {
+		var quat Quat
+
+		BeforeEach(func() {
+			quat = NewQuat(1.0, 2.0, 3.0, 4.0)
+		})
+
+		Specify("#Mul", func() {
+			other := NewQuat(5.0, 6.0, 7.0, 8.0)
+			expected := QuatProd(quat, other)
+			quat.Mul(other)
+			Expect(quat).To(HaveQuatCoords(expected.W, expected.X, expected.Y, expected.Z))
+		})
+
+		Specify("#Conjugate", func() {
+			quat.Conjugate()
+			Expect(quat).To(HaveQuatCoords(1.0, -2.0, -3.0, -4.0))
+		})
+
+		Specify("#Normalize", func() {
+			expected := UnitQuat(quat)
+			quat.Normalize()
+			Expect(quat).To(HaveQuatCoords(expected.W, expected.X, expected.Y, expected.Z))
+		})
+	})
+
+	Describe("Mat2", func() {
+		var matrix Mat2
+
+		BeforeEach(func() {
+			matrix = NewMat2(
+				1.0, 2.0,
+				3.0, 4.0,
+			)
+		})
+
+		Specify("#Mul", func() {
+			other := NewMat2(
+				5.0, 6.0,
+				7.0, 8.0,
+			)
+			matrix.Mul(&other)
+			Expect(matrix).To(HaveMat2Elements(
+				19.0, 22.0,
+				43.0, 50.0,
+			))
+		})
+
+		Specify("#Mul (self)", func() {
+			matrix.Mul(&matrix)
+			Expect(matrix).To(HaveMat2Elements(
+				7.0, 10.0,
+				15.0, 22.0,
+			))
+		})
+
+		Specify("#Transpose", func() {
+			matrix.Transpose()
+			Expect(matrix).To(HaveMat2Elements(
+				1.0, 3.0,
+				2.0, 4.0,
+			))
+		})
+	})
+
+	Describe("Mat3", func() {
+		var matrix Mat3
+
+		BeforeEach(func() {
+			matrix = NewMat3(
+				1.0, 2.0, 3.0,
+				4.0, 5.0, 6.0,
+				7.0, 8.0, 9.0,
+			)
+		})
+
+		Specify("#Mul", func() {
+			other := NewMat3(
+				9.0, 8.0, 7.0,
+				6.0, 5.0, 4.0,
+				3.0, 2.0, 1.0,
+			)
+			expected := Mat3Prod(matrix, other)
+			matrix.Mul(&other)
+			Expect(matrix).To(Equal(expected))
+		})
+
+		Specify("#Mul (self)", func() {
+			expected := Mat3Prod(matrix, matrix)
+			matrix.Mul(&matrix)
+			Expect(matrix).To(Equal(expected))
+		})
+
+		Specify("#Transpose", func() {
+			matrix.Transpose()
+			Expect(matrix).To(HaveMat3Elements(
+				1.0, 4.0, 7.0,
+				2.0, 5.0, 8.0,
+				3.0, 6.0, 9.0,
+			))
+		})
+	})
+
+	Describe("Mat4", func() {
+		var matrix Mat4
+
+		BeforeEach(func() {
+			matrix = NewMat4(
+				1.0, 2.0, 3.0, 4.0,
+				5.0, 6.0, 7.0, 8.0,
+				9.0, 10.0, 11.0, 12.0,
+				13.0, 14.0, 15.0, 16.0,
+			)
+		})
+
+		Specify("#Mul", func() {
+			other := NewMat4(
+				16.0, 15.0, 14.0, 13.0,
+				12.0, 11.0, 10.0, 9.0,
+				8.0, 7.0, 6.0, 5.0,
+				4.0, 3.0, 2.0, 1.0,
+			)
+			expected := Mat4Prod(matrix, other)
+			matrix.Mul(&other)
+			Expect(matrix).To(Equal(expected))
+		})
+
+		Specify("#Mul (self)", func() {
+			expected := Mat4Prod(matrix, matrix)
+			matrix.Mul(&matrix)
+			Expect(matrix).To(Equal(expected))
+		})
+
+		Specify("#Transpose", func() {
+			matrix.Transpose()
+			Expect(matrix).To(HaveMat4Elements(
+				1.0, 5.0, 9.0, 13.0,
+				2.0, 6.0, 10.0, 14.0,
+				3.0, 7.0, 11.0, 15.0,
+				4.0, 8.0, 12.0, 16.0,
+			))
+		})
+	})
+})
@@ -85,6 +85,71 @@ var _ = Describe("Mat3", func() {
 		Expect(transformedVector).To(HaveVec3Coords(0.866025403784, 0.5, 1.0))
 	})
 
+	Specify("DiagonalMat3", func() {
+		scaleMatrix := DiagonalMat3(2.0, -3.0, 4.0)
+		transformedVector := Mat3Vec3Prod(scaleMatrix, vector)
+		Expect(transformedVector).To(HaveVec3Coords(5.0, -10.5, 4.0))
+	})
+
+	Specify("AxisAngleMat3", func() {
+		rotationMatrix := AxisAngleMat3(Degrees(90.0), NewVec3(0.0, 0.0, 2.0))
+		transformedVector := Mat3Vec3Prod(rotationMatrix, NewVec3(1.0, 0.0, 0.0))
+		Expect(transformedVector).To(HaveVec3Coords(0.0, 1.0, 0.0))
+
+		rotationMatrix = AxisAngleMat3(Degrees(60.0), NewVec3(2.0, 5.0, 3.0))
+		expected := QuatVec3Rotation(RotationQuat(Degrees(60.0), NewVec3(2.0, 5.0, 3.0)), vector)
+		transformedVector = Mat3Vec3Prod(rotationMatrix, vector)
+		Expect(transformedVector).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+	})
+
+	Specify("QuatToMat3", func() {
+		rotationMatrix := QuatToMat3(RotationQuat(Degrees(90.0), BasisYVec3()))
+		Expect(rotationMatrix).To(HaveMat3Elements(
+			0.0, 0.0, 1.0,
+			0.0, 1.0, 0.0,
+			-1.0, 0.0, 0.0,
+		))
+	})
+
+	Specify("OrientationMat3", func() {
+		orientationMatrix := OrientationMat3(
+			NewVec3(1.0, 2.0, 3.0),
+			NewVec3(4.0, 5.0, 6.0),
+			NewVec3(7.0, 8.0, 9.0),
+		)
+		Expect(orientationMatrix).To(HaveMat3Elements(
+			1.0, 4.0, 7.0,
+			2.0, 5.0, 8.0,
+			3.0, 6.0, 9.0,
+		))
+	})
+
+	Specify("NormalMat3", func() {
+		normalMatrix := NormalMat3(TRSMat4(NewVec3(1.0, 2.0, 3.0), IdentityQuat(), NewVec3(2.0, 4.0, 1.0)))
+		Expect(normalMatrix).To(HaveMat3Elements(
+			0.5, 0.0, 0.0,
+			0.0, 0.25, 0.0,
+			0.0, 0.0, 1.0,
+		))
+
+		rotation := RotationQuat(Degrees(90.0), BasisYVec3())
+		normalMatrix = NormalMat3(TRSMat4(NewVec3(1.0, 2.0, 3.0), rotation, NewVec3(1.0, 1.0, 1.0)))
+		Expect(normalMatrix).To(HaveMat3Elements(
+			0.0, 0.0, 1.0,
+			0.0, 1.0, 0.0,
+			-1.0, 0.0, 0.0,
+		))
+	})
+
+	Specify("Vec3OuterProd", func() {
+		result := Vec3OuterProd(NewVec3(1.0, 2.0, 3.0), NewVec3(4.0, 5.0, 6.0))
+		Expect(result).To(HaveMat3Elements(
+			4.0, 5.0, 6.0,
+			8.0, 10.0, 12.0,
+			12.0, 15.0, 18.0,
+		))
+	})
+
 	Specify("OrthoMat3", func() {
 		orthoMatrix := OrthoMat3(-1.1, 2.1, 1.5, -3.4)
 
@@ -130,6 +195,25 @@ var _ = Describe("Mat3", func() {
 		))
 	})
 
+	Specify("AdjugateMat3", func() {
+		matrix := NewMat3(
+			1.0, 2.0, 3.0,
+			4.0, 5.0, 6.0,
+			7.0, 8.0, 10.0,
+		)
+		adjugateMatrix := AdjugateMat3(matrix)
+		Expect(adjugateMatrix).To(HaveMat3Elements(
+			2.0, 4.0, -3.0,
+			2.0, -11.0, 6.0,
+			-3.0, 6.0, -3.0,
+		))
+		Expect(Mat3Prod(adjugateMatrix, matrix)).To(HaveMat3Elements(
+			-3.0, 0.0, 0.0,
+			0.0, -3.0, 0.0,
+			0.0, 0.0, -3.0,
+		))
+	})
+
 	Specify("TransformationMat3", func() {
 		matrix := TransformationMat3(
 			NewVec2(1.0, 2.0),
@@ -333,6 +417,25 @@ var _ = Describe("Mat3", func() {
 		Expect(vector).To(HaveVec2Coords(0.3, 0.6))
 	})
 
+	Specify("#Determinant", func() {
+		Expect(matrix.Determinant()).To(EqualFloat32(0.0))
+
+		matrix = NewMat3(
+			1.0, 2.0, 3.0,
+			4.0, 5.0, 6.0,
+			7.0, 8.0, 10.0,
+		)
+		Expect(matrix.Determinant()).To(EqualFloat32(-3.0))
+	})
+
+	Specify("#Rotation", func() {
+		rotation := QuatToMat3(RotationQuat(Degrees(60.0), NewVec3(2.0, 5.0, 3.0))).Rotation()
+		Expect(rotation).To(HaveQuatCoords(0.866025403784, 0.162221421130763, 0.405553552826907, 0.243332131696144))
+
+		rotation = AxisAngleMat3(Degrees(180.0), BasisXVec3()).Rotation()
+		Expect(rotation).To(HaveQuatCoords(0.0, 1.0, 0.0, 0.0))
+	})
+
 	Specify("#RowMajorArray", func() {
 		array := matrix.RowMajorArray()
 		Expect(array[0]).To(EqualFloat32(0.1))
@@ -135,6 +135,49 @@ var _ = Describe("Mat4", func() {
 		Expect(projectedFarCorner).To(HaveVec4Coords(1.0, 1.0, 1.0, 1.0))
 	})
 
+	Specify("PerspectiveFovMat4", func() {
+		perspectiveMatrix := PerspectiveFovMat4(Degrees(90.0), 2.0, 1.5, 3.0)
+		expectedMatrix := PerspectiveMat4(-3.0, 3.0, -1.5, 1.5, 1.5, 3.0)
+		Expect(perspectiveMatrix).To(HaveMat4Elements(
+			expectedMatrix.M11, expectedMatrix.M12, expectedMatrix.M13, expectedMatrix.M14,
+			expectedMatrix.M21, expectedMatrix.M22, expectedMatrix.M23, expectedMatrix.M24,
+			expectedMatrix.M31, expectedMatrix.M32, expectedMatrix.M33, expectedMatrix.M34,
+			expectedMatrix.M41, expectedMatrix.M42, expectedMatrix.M43, expectedMatrix.M44,
+		))
+	})
+
+	Specify("LookAtMat4", func() {
+		viewMatrix := LookAtMat4(NewVec3(0.0, 0.0, 5.0), NewVec3(0.0, 0.0, 0.0), NewVec3(0.0, 1.0, 0.0))
+		Expect(viewMatrix).To(HaveMat4Elements(
+			1.0, 0.0, 0.0, 0.0,
+			0.0, 1.0, 0.0, 0.0,
+			0.0, 0.0, 1.0, -5.0,
+			0.0, 0.0, 0.0, 1.0,
+		))
+
+		viewMatrix = LookAtMat4(NewVec3(2.0, 1.0, 0.0), NewVec3(-3.0, 1.0, 0.0), NewVec3(0.0, 1.0, 0.0))
+		Expect(Mat4Vec3Transformation(viewMatrix, NewVec3(-3.0, 1.0, 0.0))).To(HaveVec3Coords(0.0, 0.0, -5.0))
+		Expect(Mat4Vec3Transformation(viewMatrix, NewVec3(2.0, 2.0, 0.0))).To(HaveVec3Coords(0.0, 1.0, 0.0))
+		Expect(Mat4Vec3Transformation(viewMatrix, NewVec3(2.0, 1.0, -1.0))).To(HaveVec3Coords(1.0, 0.0, 0.0))
+	})
+
+	Specify("LookAtTransformationMat4", func() {
+		eye := NewVec3(2.0, 1.0, 0.0)
+		target := NewVec3(-3.0, 1.0, 0.0)
+		up := NewVec3(0.0, 1.0, 0.0)
+		cameraMatrix := LookAtTransformationMat4(eye, target, up)
+		Expect(cameraMatrix.Translation()).To(HaveVec3Coords(2.0, 1.0, 0.0))
+		Expect(cameraMatrix.OrientationZ()).To(HaveVec3Coords(1.0, 0.0, 0.0))
+
+		product := Mat4Prod(LookAtMat4(eye, target, up), cameraMatrix)
+		Expect(product).To(HaveMat4Elements(
+			1.0, 0.0, 0.0, 0.0,
+			0.0, 1.0, 0.0, 0.0,
+			0.0, 0.0, 1.0, 0.0,
+			0.0, 0.0, 0.0, 1.0,
+		))
+	})
+
 	Specify("FastInverseMat4", func() {
 		matrix = IdentityMat4()
 		matrix = Mat4Prod(matrix, TranslationMat4(1.5, 2.3, 3.7))
@@ -443,6 +486,14 @@ var _ = Describe("Mat4", func() {
 		Expect(vector).To(HaveVec3Coords(0.4, 0.8, 1.2))
 	})
 
+	Specify("#Determinant", func() {
+		Expect(IdentityMat4().Determinant()).To(EqualFloat32(1.0))
+		Expect(ScaleMat4(2.0, 3.0, 4.0).Determinant()).To(EqualFloat32(24.0))
+
+		transform := TRSMat4(NewVec3(1.0, 2.0, 3.0), RotationQuat(Degrees(30.0), NewVec3(1.0, 2.0, 3.0)), NewVec3(2.0, 3.0, 4.0))
+		Expect(transform.Determinant()).To(BeNumerically("~", 24.0, 0.0001))
+	})
+
 	Specify("#Scale", func() {
 		Expect(IdentityMat4().Scale()).To(HaveVec3Coords(1.0, 1.0, 1.0))
 		Expect(matrix.Scale()).To(HaveVec3Coords(
@@ -0,0 +1,201 @@
+package sprec_test
+
+import (
+	"encoding/binary"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+)
+
+var _ = Describe("Packing", func() {
+	type material struct {
+		Position  Vec3
+		Intensity float32
+		Color     Vec3
+		Transform Mat3
+		Weights   [2]float32
+		Rotation  Quat
+	}
+
+	type light struct {
+		Color Vec3
+		Range float32
+	}
+
+	type scene struct {
+		Lights  [2]light
+		Count   int32
+		Enabled bool
+		_       uint32
+		Ambient Vec2
+	}
+
+	var value material
+
+	componentAt := func(data []byte, offset int) float32 {
+		return math.Float32frombits(binary.LittleEndian.Uint32(data[offset:]))
+	}
+
+	BeforeEach(func() {
+		value = material{
+			Position:  NewVec3(1.0, 2.0, 3.0),
+			Intensity: 4.0,
+			Color:     NewVec3(5.0, 6.0, 7.0),
+			Transform: NewMat3(
+				11.0, 12.0, 13.0,
+				21.0, 22.0, 23.0,
+				31.0, 32.0, 33.0,
+			),
+			Weights:  [2]float32{8.0, 9.0},
+			Rotation: NewQuat(10.0, 20.0, 30.0, 40.0),
+		}
+	})
+
+	Specify("BufferLayout#String", func() {
+		Expect(BufferLayoutStd140.String()).To(Equal("std140"))
+		Expect(BufferLayoutStd430.String()).To(Equal("std430"))
+		Expect(BufferLayoutScalar.String()).To(Equal("scalar"))
+		Expect(BufferLayout(7).String()).To(Equal("BufferLayout(7)"))
+	})
+
+	DescribeTable("PackBuffer",
+		func(layout BufferLayout, size, colorOffset, transformOffset, columnStride, weightsOffset, weightStride, rotationOffset int) {
+			data, err := PackBuffer(layout, value)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(HaveLen(size))
+
+			Expect(componentAt(data, 0)).To(Equal(float32(1.0)))
+			Expect(componentAt(data, 8)).To(Equal(float32(3.0)))
+			Expect(componentAt(data, 12)).To(Equal(float32(4.0)))
+			Expect(componentAt(data, colorOffset)).To(Equal(float32(5.0)))
+			Expect(componentAt(data, transformOffset)).To(Equal(float32(11.0)))
+			Expect(componentAt(data, transformOffset+4)).To(Equal(float32(21.0)))
+			Expect(componentAt(data, transformOffset+columnStride)).To(Equal(float32(12.0)))
+			Expect(componentAt(data, transformOffset+2*columnStride+8)).To(Equal(float32(33.0)))
+			Expect(componentAt(data, weightsOffset)).To(Equal(float32(8.0)))
+			Expect(componentAt(data, weightsOffset+weightStride)).To(Equal(float32(9.0)))
+			Expect(componentAt(data, rotationOffset)).To(Equal(float32(20.0)))
+			Expect(componentAt(data, rotationOffset+12)).To(Equal(float32(10.0)))
+		},
+		Entry("std140", BufferLayoutStd140, 128, 16, 32, 16, 80, 16, 112),
+		Entry("std430", BufferLayoutStd430, 112, 16, 32, 16, 80, 4, 96),
+		Entry("scalar", BufferLayoutScalar, 88, 16, 28, 12, 64, 4, 72),
+	)
+
+	Specify("PackBuffer with nested structs", func() {
+		data, err := PackBuffer(BufferLayoutStd140, &scene{
+			Lights: [2]light{
+				{Color: NewVec3(1.0, 2.0, 3.0), Range: 4.0},
+				{Color: NewVec3(5.0, 6.0, 7.0), Range: 8.0},
+			},
+			Count:   2,
+			Enabled: true,
+			Ambient: NewVec2(0.5, 0.25),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(64))
+		Expect(componentAt(data, 12)).To(Equal(float32(4.0)))
+		Expect(componentAt(data, 16)).To(Equal(float32(5.0)))
+		Expect(componentAt(data, 28)).To(Equal(float32(8.0)))
+		Expect(binary.LittleEndian.Uint32(data[32:])).To(Equal(uint32(2)))
+		Expect(binary.LittleEndian.Uint32(data[36:])).To(Equal(uint32(1)))
+		Expect(binary.LittleEndian.Uint32(data[40:])).To(Equal(uint32(0)))
+		Expect(componentAt(data, 48)).To(Equal(float32(0.5)))
+	})
+
+	Specify("PackBuffer with a matrix", func() {
+		matrix := RotationMat4(Degrees(30.0), 0.0, 1.0, 0.0)
+		expected, err := matrix.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		for _, layout := range []BufferLayout{BufferLayoutStd140, BufferLayoutStd430, BufferLayoutScalar} {
+			Expect(PackBuffer(layout, matrix)).To(Equal(expected))
+		}
+	})
+
+	DescribeTable("PackBuffer with a Mat2",
+		func(layout BufferLayout, expectedSize, expectedStride int) {
+			matrix := NewMat2(
+				1.0, 2.0,
+				3.0, 4.0,
+			)
+			data, err := PackBuffer(layout, matrix)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(HaveLen(expectedSize))
+			Expect(componentAt(data, 0)).To(Equal(float32(1.0)))
+			Expect(componentAt(data, 4)).To(Equal(float32(3.0)))
+			Expect(componentAt(data, expectedStride)).To(Equal(float32(2.0)))
+			Expect(componentAt(data, expectedStride+4)).To(Equal(float32(4.0)))
+
+			report, err := InspectBufferLayout(layout, matrix)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Size).To(Equal(expectedSize))
+			Expect(report.Alignment).To(Equal(expectedStride))
+		},
+		Entry("std140", BufferLayoutStd140, 32, 16),
+		Entry("std430", BufferLayoutStd430, 16, 8),
+	)
+
+	Specify("PackBuffer with a scalar array of unevenly sized structs", func() {
+		type sample struct {
+			Weight float64
+			Index  float32
+		}
+		value := [2]sample{{Weight: 1.0, Index: 2.0}, {Weight: 3.0, Index: 4.0}}
+		data, err := PackBuffer(BufferLayoutScalar, value)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(32))
+		Expect(math.Float64frombits(binary.LittleEndian.Uint64(data[16:]))).To(Equal(float64(3.0)))
+		Expect(math.Float32frombits(binary.LittleEndian.Uint32(data[24:]))).To(Equal(float32(4.0)))
+
+		report, err := InspectBufferLayout(BufferLayoutScalar, value)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Size).To(Equal(32))
+		Expect(report.Entries[3].Path).To(Equal("[1]"))
+		Expect(report.Entries[3].Offset).To(Equal(16))
+	})
+
+	Specify("PackBuffer with unsupported values", func() {
+		_, err := PackBuffer(BufferLayoutStd140, struct{ Name string }{})
+		Expect(err).To(MatchError(ContainSubstring("field Name")))
+		_, err = PackBuffer(BufferLayoutStd140, (*scene)(nil))
+		Expect(err).To(HaveOccurred())
+		_, err = PackBuffer(BufferLayoutStd140, nil)
+		Expect(err).To(HaveOccurred())
+		_, err = PackBuffer(BufferLayout(7), value)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Specify("AppendPackedBuffer", func() {
+		data, err := AppendPackedBuffer(make([]byte, 16), BufferLayoutStd140, NewVec3(1.0, 2.0, 3.0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(28))
+		Expect(componentAt(data, 16)).To(Equal(float32(1.0)))
+		Expect(componentAt(data, 24)).To(Equal(float32(3.0)))
+	})
+
+	Specify("InspectBufferLayout", func() {
+		report, err := InspectBufferLayout(BufferLayoutStd140, scene{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Layout).To(Equal(BufferLayoutStd140))
+		Expect(report.Size).To(Equal(64))
+		Expect(report.Alignment).To(Equal(16))
+		Expect(report.Entries).To(Equal([]BufferLayoutEntry{
+			{Path: "Lights", Type: "light[2]", Offset: 0, Size: 32, Alignment: 16, Stride: 16},
+			{Path: "Lights[0]", Type: "light", Offset: 0, Size: 16, Alignment: 16},
+			{Path: "Lights[0].Color", Type: "vec3", Offset: 0, Size: 12, Alignment: 16},
+			{Path: "Lights[0].Range", Type: "float", Offset: 12, Size: 4, Alignment: 4},
+			{Path: "Lights[1]", Type: "light", Offset: 16, Size: 16, Alignment: 16},
+			{Path: "Lights[1].Color", Type: "vec3", Offset: 16, Size: 12, Alignment: 16},
+			{Path: "Lights[1].Range", Type: "float", Offset: 28, Size: 4, Alignment: 4},
+			{Path: "Count", Type: "int", Offset: 32, Size: 4, Alignment: 4},
+			{Path: "Enabled", Type: "bool", Offset: 36, Size: 4, Alignment: 4},
+			{Path: "_", Type: "uint", Offset: 40, Size: 4, Alignment: 4},
+			{Path: "Ambient", Type: "vec2", Offset: 48, Size: 8, Alignment: 8},
+		}))
+		Expect(report.String()).To(ContainSubstring("std140 layout of sprec_test.scene (size 64, alignment 16)"))
+		Expect(report.String()).To(MatchRegexp(`\n\s+16\s+12\s+16\s+0\s+vec3 Lights\[1\]\.Color\n`))
+	})
+})
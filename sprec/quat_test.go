@@ -52,6 +52,44 @@ var _ = Describe("QuatTest", func() {
 		Expect(QuatVec3Rotation(rotation, zAxis)).To(HaveVec3Coords(0.5, 0.6123724356957945, 0.6123724356957945))
 	})
 
+	Specify("ShortestArcQuat", func() {
+		result := ShortestArcQuat(NewVec3(1.0, 0.0, 0.0), NewVec3(0.0, 2.0, 0.0))
+		Expect(result).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.0, 0.7071067811865476))
+
+		result = ShortestArcQuat(NewVec3(1.0, 2.0, 3.0), NewVec3(2.0, 4.0, 6.0))
+		Expect(result).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+
+		result = ShortestArcQuat(NewVec3(1.0, 0.0, 0.0), NewVec3(-3.0, 0.0, 0.0))
+		Expect(result).To(HaveQuatCoords(0.0, 0.0, 0.7071067811865476, 0.7071067811865476))
+		Expect(QuatVec3Rotation(result, NewVec3(1.0, 0.0, 0.0))).To(HaveVec3Coords(-1.0, 0.0, 0.0))
+	})
+
+	Specify("ShortestArcQuat with nearly opposite vectors", func() {
+		from := NewVec3(1.0, 0.0, 0.0)
+		to := NewVec3(-1.0, 0.0001, 0.0)
+		result := ShortestArcQuat(from, to)
+		Expect(result).To(HaveQuatCoords(0.0, 0.0, 0.7071067811865476, 0.7071067811865476))
+		Expect(Vec3Dot(QuatVec3Rotation(result, from), UnitVec3(to))).To(BeNumerically("~", 1.0, 0.000001))
+	})
+
+	Specify("LookRotationQuat", func() {
+		result := LookRotationQuat(NewVec3(2.0, 0.0, 0.0), NewVec3(0.0, 1.0, 0.0))
+		Expect(result).To(HaveQuatCoords(0.7071067811865476, 0.0, -0.7071067811865476, 0.0))
+		Expect(QuatVec3Rotation(result, NewVec3(0.0, 0.0, -1.0))).To(HaveVec3Coords(1.0, 0.0, 0.0))
+
+		eye := NewVec3(1.0, 2.0, 3.0)
+		target := NewVec3(-2.0, 4.0, 1.0)
+		up := NewVec3(0.0, 1.0, 0.0)
+		result = LookRotationQuat(Vec3Diff(target, eye), up)
+		expected := LookAtTransformationMat4(eye, target, up).Rotation()
+		Expect(result).To(HaveQuatCoords(expected.W, expected.X, expected.Y, expected.Z))
+
+		result = LookRotationQuat(NewVec3(0.0, 3.0, 0.0), NewVec3(0.0, 1.0, 0.0))
+		Expect(result.OrientationZ()).To(HaveVec3Coords(0.0, -1.0, 0.0))
+		Expect(Vec3Dot(result.OrientationX(), result.OrientationZ())).To(BeNumerically("~", 0.0, 0.000001))
+		Expect(result.OrientationX().Length()).To(BeNumerically("~", 1.0, 0.000001))
+	})
+
 	Specify("ConjugateQuat", func() {
 		conjugate := ConjugateQuat(quat)
 		Expect(conjugate).To(HaveQuatCoords(5.1, 4.1, -3.1, 2.1))
@@ -127,6 +165,50 @@ var _ = Describe("QuatTest", func() {
 		Expect(pow).To(HaveQuatCoords(expectedQuat.W, expectedQuat.X, expectedQuat.Y, expectedQuat.Z))
 	})
 
+	Specify("QuatExp", func() {
+		result := QuatExp(NewQuat(0.0, 0.0, 0.0, 0.7853981633974483))
+		Expect(result).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.0, 0.7071067811865476))
+
+		result = QuatExp(NewQuat(1.0, 0.0, 0.0, 0.0))
+		Expect(result).To(HaveQuatCoords(2.718281828459045, 0.0, 0.0, 0.0))
+	})
+
+	Specify("QuatLog", func() {
+		result := QuatLog(RotationQuat(Degrees(90.0), BasisZVec3()))
+		Expect(result).To(HaveQuatCoords(0.0, 0.0, 0.0, 0.7853981633974483))
+
+		result = QuatLog(IdentityQuat())
+		Expect(result).To(HaveQuatCoords(0.0, 0.0, 0.0, 0.0))
+
+		result = QuatExp(QuatLog(quat))
+		Expect(result).To(HaveQuatCoords(quat.W, quat.X, quat.Y, quat.Z))
+	})
+
+	Specify("AngularVelocityVec3", func() {
+		first := RotationQuat(Degrees(30.0), BasisXVec3())
+		second := QuatProd(RotationQuat(Degrees(90.0), BasisZVec3()), first)
+		result := AngularVelocityVec3(first, second, 2.0)
+		Expect(result).To(HaveVec3Coords(0.0, 0.0, 0.7853981633974483))
+
+		result = AngularVelocityVec3(first, NegativeQuat(second), 2.0)
+		Expect(result).To(HaveVec3Coords(0.0, 0.0, 0.7853981633974483))
+	})
+
+	Specify("AngularVelocityQuat", func() {
+		result := AngularVelocityQuat(NewVec3(0.0, 0.0, 0.7853981633974483), 2.0)
+		Expect(result).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.0, 0.7071067811865476))
+	})
+
+	Specify("QuatFirstOrderIntegration", func() {
+		result := QuatFirstOrderIntegration(IdentityQuat(), NewVec3(0.0, 0.0, 1.5707963267948966), 0.1)
+		Expect(result).To(HaveQuatCoords(0.9969299445800113, 0.0, 0.0, 0.07829869475090608))
+	})
+
+	Specify("QuatSecondOrderIntegration", func() {
+		result := QuatSecondOrderIntegration(IdentityQuat(), NewVec3(0.0, 0.0, 1.5707963267948966), 0.1)
+		Expect(result).To(HaveQuatCoords(0.99691100702485, 0.0, 0.0, 0.07853944278322442))
+	})
+
 	Specify("QuatSlerp", func() {
 		first := RotationQuat(Degrees(25), NewVec3(1.0, 2.0, 3.0))
 		second := RotationQuat(Degrees(45), NewVec3(1.0, 2.0, 3.0))
@@ -151,6 +233,36 @@ var _ = Describe("QuatTest", func() {
 		Expect(slerp).To(HaveQuatCoords(expectedQuat.W, expectedQuat.X, expectedQuat.Y, expectedQuat.Z))
 	})
 
+	Specify("QuatSquad", func() {
+		first := IdentityQuat()
+		second := RotationQuat(Degrees(90.0), BasisZVec3())
+		result := QuatSquad(first, first, second, second, 0.5)
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		firstControl := RotationQuat(Degrees(20.0), BasisXVec3())
+		secondControl := RotationQuat(Degrees(30.0), BasisYVec3())
+		result = QuatSquad(first, firstControl, secondControl, second, 0.0)
+		Expect(result).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		result = QuatSquad(first, firstControl, secondControl, second, 1.0)
+		Expect(result).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.0, 0.7071067811865476))
+	})
+
+	Specify("QuatSquadControl", func() {
+		prev := IdentityQuat()
+		current := RotationQuat(Degrees(45.0), BasisZVec3())
+		next := RotationQuat(Degrees(90.0), BasisZVec3())
+		result := QuatSquadControl(prev, current, next)
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		result = QuatSquadControl(prev, current, NegativeQuat(next))
+		Expect(result).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.0, 0.3826834323650898))
+
+		next = QuatProd(RotationQuat(Degrees(45.0), BasisXVec3()), current)
+		result = QuatSquadControl(prev, current, next)
+		Expect(QuatDot(result, current)).To(BeNumerically(">", 0.99))
+		Expect(QuatDot(result, current)).To(BeNumerically("<", 0.9999))
+	})
+
 	Specify("#QuatVec3Rotation", func() {
 		quat := RotationQuat(Degrees(180), NewVec3(1.0, 1.0, 1.0))
 		rotatedVector := QuatVec3Rotation(quat, NewVec3(1.0, 0.0, 0.0))
@@ -167,6 +279,52 @@ var _ = Describe("QuatTest", func() {
 		Expect(QuatProd(quat, inverse)).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
 	})
 
+	Specify("ClampTwistQuat", func() {
+		joint := QuatProd(RotationQuat(Degrees(30.0), BasisXVec3()), RotationQuat(Degrees(40.0), BasisYVec3()))
+
+		result := ClampTwistQuat(joint, BasisYVec3(), Degrees(-20.0), Degrees(25.0))
+		swing, twist := result.SwingTwist(BasisYVec3())
+		Expect(swing).To(HaveQuatCoords(0.9659258262890683, 0.25881904510252074, 0.0, 0.0))
+		Expect(twist).To(HaveQuatCoords(0.9762960071199334, 0.0, 0.21643961393810288, 0.0))
+
+		result = ClampTwistQuat(joint, BasisYVec3(), Degrees(60.0), Degrees(90.0))
+		_, twist = result.SwingTwist(BasisYVec3())
+		Expect(twist).To(HaveQuatCoords(0.8660254037844387, 0.0, 0.5, 0.0))
+
+		result = ClampTwistQuat(joint, BasisYVec3(), Degrees(-45.0), Degrees(45.0))
+		Expect(result).To(HaveQuatCoords(joint.W, joint.X, joint.Y, joint.Z))
+	})
+
+	Specify("ClampSwingQuat", func() {
+		joint := QuatProd(RotationQuat(Degrees(30.0), BasisXVec3()), RotationQuat(Degrees(40.0), BasisYVec3()))
+
+		result := ClampSwingQuat(joint, BasisYVec3(), Degrees(20.0))
+		swing, twist := result.SwingTwist(BasisYVec3())
+		Expect(swing).To(HaveQuatCoords(0.984807753012208, 0.17364817766693033, 0.0, 0.0))
+		Expect(twist).To(HaveQuatCoords(0.9396926207859084, 0.0, 0.3420201433256687, 0.0))
+
+		result = ClampSwingQuat(joint, BasisYVec3(), Degrees(45.0))
+		Expect(result).To(HaveQuatCoords(joint.W, joint.X, joint.Y, joint.Z))
+	})
+
+	Specify("ClampSwingEllipseQuat", func() {
+		twist := RotationQuat(Degrees(40.0), BasisYVec3())
+
+		joint := QuatProd(RotationQuat(Degrees(30.0), BasisXVec3()), twist)
+		result := ClampSwingEllipseQuat(joint, BasisYVec3(), BasisXVec3(), Degrees(10.0), Degrees(20.0))
+		swing, _ := result.SwingTwist(BasisYVec3())
+		Expect(swing).To(HaveQuatCoords(0.984807753012208, 0.17364817766693033, 0.0, 0.0))
+
+		joint = QuatProd(RotationQuat(Degrees(30.0), BasisZVec3()), twist)
+		result = ClampSwingEllipseQuat(joint, BasisYVec3(), BasisXVec3(), Degrees(10.0), Degrees(20.0))
+		swing, _ = result.SwingTwist(BasisYVec3())
+		Expect(swing).To(HaveQuatCoords(0.9961946980917455, 0.0, 0.0, 0.08715574274765817))
+
+		joint = QuatProd(RotationQuat(Degrees(15.0), BasisXVec3()), twist)
+		result = ClampSwingEllipseQuat(joint, BasisYVec3(), BasisXVec3(), Degrees(10.0), Degrees(20.0))
+		Expect(result).To(HaveQuatCoords(joint.W, joint.X, joint.Y, joint.Z))
+	})
+
 	DescribeTable("#IsNaN",
 		func(quat Quat, expected bool) {
 			Expect(quat.IsNaN()).To(Equal(expected))
@@ -247,6 +405,31 @@ var _ = Describe("QuatTest", func() {
 		Expect(quat.OrientationZ()).To(HaveVec3Coords(1.0, 0.0, 0.0))
 	})
 
+	Specify("#AxisAngle", func() {
+		axis, angle := RotationQuat(Degrees(60.0), NewVec3(2.0, 5.0, 3.0)).AxisAngle()
+		Expect(axis).To(HaveVec3Coords(0.32444284226152503, 0.8111071056538127, 0.48666426339228763))
+		Expect(angle.Degrees()).To(BeNumerically("~", 60.0, 0.0001))
+
+		axis, angle = RotationQuat(Degrees(270.0), BasisYVec3()).AxisAngle()
+		Expect(axis).To(HaveVec3Coords(0.0, 1.0, 0.0))
+		Expect(angle.Degrees()).To(BeNumerically("~", 270.0, 0.0001))
+
+		axis, angle = IdentityQuat().AxisAngle()
+		Expect(axis).To(HaveVec3Coords(1.0, 0.0, 0.0))
+		Expect(angle.Degrees()).To(BeNumerically("~", 0.0, 0.0001))
+	})
+
+	Specify("#SwingTwist", func() {
+		joint := QuatProd(RotationQuat(Degrees(30.0), BasisXVec3()), RotationQuat(Degrees(40.0), BasisYVec3()))
+		swing, twist := joint.SwingTwist(NewVec3(0.0, 2.0, 0.0))
+		Expect(swing).To(HaveQuatCoords(0.9659258262890683, 0.25881904510252074, 0.0, 0.0))
+		Expect(twist).To(HaveQuatCoords(0.9396926207859084, 0.0, 0.3420201433256687, 0.0))
+
+		swing, twist = RotationQuat(Degrees(180.0), BasisXVec3()).SwingTwist(BasisYVec3())
+		Expect(swing).To(HaveQuatCoords(0.0, 1.0, 0.0, 0.0))
+		Expect(twist).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+	})
+
 	Specify("#EulerAngles", func() {
 		orders := []RotationOrder{
 			RotationOrderGlobalXYZ,
@@ -0,0 +1,104 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("QuatSpline", func() {
+	var keyframes []QuatKeyframe
+
+	// angularVelocities returns the average angular velocities right before
+	// and right after the specified time.
+	angularVelocities := func(spline QuatSpline, time float32) (Vec3, Vec3) {
+		const dt = 0.001
+		before := AngularVelocityVec3(spline.Sample(time-dt), spline.Sample(time), dt)
+		after := AngularVelocityVec3(spline.Sample(time), spline.Sample(time+dt), dt)
+		return before, after
+	}
+
+	BeforeEach(func() {
+		keyframes = []QuatKeyframe{
+			{Time: 0.0, Rotation: IdentityQuat()},
+			{Time: 1.0, Rotation: RotationQuat(Degrees(90.0), BasisZVec3())},
+			{Time: 3.0, Rotation: QuatProd(RotationQuat(Degrees(90.0), BasisXVec3()), RotationQuat(Degrees(90.0), BasisZVec3()))},
+			{Time: 4.0, Rotation: RotationQuat(Degrees(30.0), BasisYVec3())},
+		}
+	})
+
+	Specify("NewQuatSpline", func() {
+		spline := NewQuatSpline(keyframes)
+		Expect(spline.Keyframes()).To(HaveLen(4))
+
+		keyframes[0].Time = 10.0
+		Expect(spline.Keyframes()[0].Time).To(Equal(float32(0.0)))
+	})
+
+	Specify("#Sample", func() {
+		spline := NewQuatSpline(keyframes)
+		for _, keyframe := range keyframes {
+			result := spline.Sample(keyframe.Time)
+			Expect(QuatDot(result, keyframe.Rotation)).To(BeNumerically("~", 1.0, 0.000001))
+		}
+
+		Expect(spline.Sample(-1.0)).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		Expect(spline.Sample(5.0)).To(HaveQuatCoords(0.9659258262890683, 0.0, 0.25881904510252074, 0.0))
+		Expect(NewQuatSpline(nil).Sample(1.0)).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+	})
+
+	Specify("#Sample with constant angular velocity", func() {
+		spline := NewQuatSpline([]QuatKeyframe{
+			{Time: 0.0, Rotation: IdentityQuat()},
+			{Time: 1.0, Rotation: RotationQuat(Degrees(60.0), BasisZVec3())},
+			{Time: 3.0, Rotation: RotationQuat(Degrees(180.0), BasisZVec3())},
+		})
+		Expect(spline.Sample(0.5)).To(HaveQuatCoords(0.9659258262890683, 0.0, 0.0, 0.25881904510252074))
+		Expect(spline.Sample(2.0)).To(HaveQuatCoords(0.5, 0.0, 0.0, 0.8660254037844386))
+	})
+
+	Specify("#Sample is smooth at keyframes", func() {
+		spline := NewQuatSpline(keyframes)
+		for _, time := range []float32{1.0, 3.0} {
+			before, after := angularVelocities(spline, time)
+			Expect(before.Length()).To(BeNumerically(">", 0.1))
+			Expect(Vec3Diff(after, before).Length()).To(BeNumerically("<", 0.05))
+		}
+	})
+
+	Specify("#Sample with tension", func() {
+		keyframes[1].Tension = 1.0
+		spline := NewQuatSpline(keyframes)
+		before, after := angularVelocities(spline, 1.0)
+		Expect(before.Length()).To(BeNumerically("<", 0.05))
+		Expect(after.Length()).To(BeNumerically("<", 0.05))
+	})
+
+	Specify("#Sample with continuity", func() {
+		keyframes[1].Continuity = -1.0
+		spline := NewQuatSpline(keyframes)
+		before, after := angularVelocities(spline, 1.0)
+		Expect(Vec3Diff(after, before).Length()).To(BeNumerically(">", 0.5))
+	})
+
+	DescribeTable("#Sample with continuity between evenly spaced keyframes",
+		func(continuity float32, expectSlerp bool) {
+			keyframes[2].Time = 2.0
+			keyframes[3].Time = 3.0
+			keyframes[1].Continuity = continuity
+			keyframes[2].Continuity = continuity
+			spline := NewQuatSpline(keyframes)
+			matchesSlerp := true
+			for _, t := range []float32{0.25, 0.5, 0.75} {
+				expected := QuatSlerp(keyframes[1].Rotation, keyframes[2].Rotation, t)
+				dot := Abs(QuatDot(spline.Sample(1.0+t), expected))
+				matchesSlerp = matchesSlerp && dot > 1.0-Epsilon
+			}
+			Expect(matchesSlerp).To(Equal(expectSlerp))
+		},
+		Entry("sharp corners", float32(-1.0), true),
+		Entry("inverted corners", float32(1.0), false),
+	)
+})
@@ -0,0 +1,220 @@
+package sprec_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Ray", func() {
+	var (
+		ray         Ray
+		maxDistance float32
+	)
+
+	BeforeEach(func() {
+		ray = NewRay(NewVec3(0.0, 0.0, 5.0), NewVec3(0.0, 0.0, -1.0))
+		maxDistance = float32(math.Inf(1))
+	})
+
+	Specify("NewRay", func() {
+		Expect(ray.Origin).To(HaveVec3Coords(0.0, 0.0, 5.0))
+		Expect(ray.Direction).To(HaveVec3Coords(0.0, 0.0, -1.0))
+	})
+
+	Specify("#Point", func() {
+		Expect(ray.Point(2.5)).To(HaveVec3Coords(0.0, 0.0, 2.5))
+	})
+
+	Describe("RaySphereIntersection", func() {
+		Specify("hits the near side of the sphere", func() {
+			hit, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 1.0), 2.0, maxDistance, false)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(2.0))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, 3.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("hits the far side when the origin is inside", func() {
+			ray.Origin = NewVec3(0.0, 0.0, 1.5)
+			hit, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 1.0), 2.0, maxDistance, false)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(2.5))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, -1.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, -1.0))
+		})
+
+		Specify("hits the near side when culling back faces", func() {
+			hit, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 1.0), 2.0, maxDistance, true)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(2.0))
+		})
+
+		Specify("misses from the inside when culling back faces", func() {
+			ray.Origin = NewVec3(0.0, 0.0, 1.5)
+			_, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 1.0), 2.0, maxDistance, true)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a sphere to the side", func() {
+			_, ok := RaySphereIntersection(ray, NewVec3(3.0, 0.0, 1.0), 2.0, maxDistance, false)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a sphere behind the origin", func() {
+			_, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 10.0), 2.0, maxDistance, false)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a sphere beyond max distance", func() {
+			_, ok := RaySphereIntersection(ray, NewVec3(0.0, 0.0, 1.0), 2.0, 1.5, false)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("RayAABBIntersection", func() {
+		var box AABB
+
+		BeforeEach(func() {
+			box = NewAABB(NewVec3(-1.0, -1.0, -1.0), NewVec3(1.0, 1.0, 2.0))
+		})
+
+		Specify("hits the near face of the box", func() {
+			hit, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(3.0))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, 2.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("hits the box at an angle", func() {
+			ray = NewRay(NewVec3(-3.0, 0.5, 0.0), NewVec3(1.0, 0.0, 0.0))
+			hit, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(2.0))
+			Expect(hit.Point).To(HaveVec3Coords(-1.0, 0.5, 0.0))
+			Expect(hit.Normal).To(HaveVec3Coords(-1.0, 0.0, 0.0))
+		})
+
+		Specify("hits the far face when the origin is inside", func() {
+			ray.Origin = NewVec3(0.0, 0.0, 0.5)
+			hit, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(1.5))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, -1.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, -1.0))
+		})
+
+		Specify("hits the near face when the origin is on it", func() {
+			ray.Origin = NewVec3(0.0, 0.0, 2.0)
+			hit, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(0.0))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, 2.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("misses a box to the side", func() {
+			ray.Origin = NewVec3(2.0, 0.0, 5.0)
+			_, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a box behind the origin", func() {
+			ray.Direction = NewVec3(0.0, 0.0, 1.0)
+			_, ok := RayAABBIntersection(ray, box, maxDistance)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a box beyond max distance", func() {
+			_, ok := RayAABBIntersection(ray, box, 2.5)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("RayPlaneIntersection", func() {
+		var plane Plane
+
+		BeforeEach(func() {
+			plane = PointNormalPlane(NewVec3(3.0, 2.0, 1.0), NewVec3(0.0, 0.0, 1.0))
+		})
+
+		Specify("hits the front of the plane", func() {
+			hit, ok := RayPlaneIntersection(ray, plane, maxDistance, true)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(4.0))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, 1.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("hits the back of the plane without culling", func() {
+			plane = InversePlane(plane)
+			hit, ok := RayPlaneIntersection(ray, plane, maxDistance, false)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(4.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, -1.0))
+		})
+
+		Specify("misses the back of the plane with culling", func() {
+			plane = InversePlane(plane)
+			_, ok := RayPlaneIntersection(ray, plane, maxDistance, true)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a parallel plane", func() {
+			plane = PointNormalPlane(NewVec3(3.0, 2.0, 1.0), NewVec3(1.0, 0.0, 0.0))
+			_, ok := RayPlaneIntersection(ray, plane, maxDistance, false)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a plane beyond max distance", func() {
+			_, ok := RayPlaneIntersection(ray, plane, 3.0, false)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("RayTriangleIntersection", func() {
+		var a, b, c Vec3
+
+		BeforeEach(func() {
+			a = NewVec3(-1.0, -1.0, 1.0)
+			b = NewVec3(1.0, -1.0, 1.0)
+			c = NewVec3(0.0, 1.0, 1.0)
+		})
+
+		Specify("hits the front of the triangle", func() {
+			hit, ok := RayTriangleIntersection(ray, a, b, c, maxDistance, true)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(4.0))
+			Expect(hit.Point).To(HaveVec3Coords(0.0, 0.0, 1.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, 1.0))
+		})
+
+		Specify("hits the back of the triangle without culling", func() {
+			hit, ok := RayTriangleIntersection(ray, a, c, b, maxDistance, false)
+			Expect(ok).To(BeTrue())
+			Expect(hit.Distance).To(EqualFloat32(4.0))
+			Expect(hit.Normal).To(HaveVec3Coords(0.0, 0.0, -1.0))
+		})
+
+		Specify("misses the back of the triangle with culling", func() {
+			_, ok := RayTriangleIntersection(ray, a, c, b, maxDistance, true)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses outside of the triangle", func() {
+			ray.Origin = NewVec3(0.9, 0.9, 5.0)
+			_, ok := RayTriangleIntersection(ray, a, b, c, maxDistance, false)
+			Expect(ok).To(BeFalse())
+		})
+
+		Specify("misses a triangle beyond max distance", func() {
+			_, ok := RayTriangleIntersection(ray, a, b, c, 3.0, false)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
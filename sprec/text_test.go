@@ -0,0 +1,190 @@
+package sprec_test
+
+import (
+	"flag"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Text", func() {
+	Specify("ParseVec2", func() {
+		vec, err := ParseVec2(NewVec2(1.5, -2.25).String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vec).To(HaveVec2Coords(1.5, -2.25))
+	})
+
+	Specify("ParseVec3", func() {
+		vec, err := ParseVec3(NewVec3(1.5, -2.25, 3.0).String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vec).To(HaveVec3Coords(1.5, -2.25, 3.0))
+	})
+
+	Specify("ParseVec4", func() {
+		vec, err := ParseVec4(" ( 1 ,2,3, 4e-1 ) ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vec).To(HaveVec4Coords(1.0, 2.0, 3.0, 0.4))
+	})
+
+	Specify("ParseQuat", func() {
+		quat, err := ParseQuat(NewQuat(1.0, 2.0, 3.0, 4.0).String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(quat).To(HaveQuatCoords(1.0, 2.0, 3.0, 4.0))
+	})
+
+	Specify("ParseMat2", func() {
+		matrix := NewMat2(
+			1.0, 2.0,
+			3.0, 4.0,
+		)
+		result, err := ParseMat2(matrix.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(matrix))
+
+		_, err = ParseMat2("((1, 2), (3, 4), (5, 6))")
+		Expect(err).To(MatchError(`invalid Mat2 "((1, 2), (3, 4), (5, 6))": expected 2 rows but got 3`))
+	})
+
+	Specify("ParseMat3", func() {
+		matrix := NewMat3(
+			1.0, 2.0, 3.0,
+			4.0, 5.0, 6.0,
+			7.0, 8.0, 9.0,
+		)
+		result, err := ParseMat3(matrix.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(matrix))
+	})
+
+	Specify("ParseMat4", func() {
+		matrix := NewMat4(
+			1.0, 2.0, 3.0, 4.0,
+			5.0, 6.0, 7.0, 8.0,
+			9.0, 10.0, 11.0, 12.0,
+			13.0, 14.0, 15.0, 16.0,
+		)
+		result, err := ParseMat4(matrix.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(matrix))
+	})
+
+	DescribeTable("ParseAngle",
+		func(text string, expected float32) {
+			angle, err := ParseAngle(text)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(angle.Radians()).To(BeNumerically("~", expected, 0.000001))
+		},
+		Entry("plain number", "1.5", float32(1.5)),
+		Entry("radians", "1.5rad", float32(1.5)),
+		Entry("radians with spaces", " 1.5 rad ", float32(1.5)),
+		Entry("degrees", "90deg", float32(math.Pi/2.0)),
+		Entry("negative degrees", "-45deg", float32(-math.Pi/4.0)),
+	)
+
+	DescribeTable("parse errors",
+		func(parse func(string) error, text, expected string) {
+			Expect(parse(text)).To(MatchError(expected))
+		},
+		Entry("missing opening parenthesis",
+			parseVec3, "1, 2, 3", `invalid Vec3 "1, 2, 3": expected "(" at start`),
+		Entry("missing closing parenthesis",
+			parseVec3, "(1, 2, 3", `invalid Vec3 "(1, 2, 3": expected ")" at end`),
+		Entry("unbalanced parentheses",
+			parseVec3, "(1, 2), (3)", `invalid Vec3 "(1, 2), (3)": unexpected ")" at offset 5`),
+		Entry("too few components",
+			parseVec3, "(1, 2)", `invalid Vec3 "(1, 2)": expected 3 components but got 2`),
+		Entry("empty",
+			parseVec3, "()", `invalid Vec3 "()": expected 3 components but got 0`),
+		Entry("missing component",
+			parseVec3, "(1, , 3)", `invalid Vec3 "(1, , 3)": component 2: missing number`),
+		Entry("invalid component",
+			parseVec3, "(1, x, 3)", `invalid Vec3 "(1, x, 3)": component 2: invalid number "x"`),
+		Entry("out of range component",
+			parseVec3, "(1, 2, 1e999)", `invalid Vec3 "(1, 2, 1e999)": component 3: number "1e999" is out of range`),
+		Entry("too few rows",
+			parseMat3, "((1, 2, 3))", `invalid Mat3 "((1, 2, 3))": expected 3 rows but got 1`),
+		Entry("invalid row",
+			parseMat3, "((1, 2, 3), 4, (7, 8, 9))", `invalid Mat3 "((1, 2, 3), 4, (7, 8, 9))": row 2: expected "(" at start`),
+		Entry("missing nested parenthesis",
+			parseMat3, "((1, 2, 3), (4, 5, 6), (7, 8, 9)", `invalid Mat3 "((1, 2, 3), (4, 5, 6), (7, 8, 9)": missing ")"`),
+		Entry("invalid matrix element",
+			parseMat4, "((1, 0, 0, 0), (0, 1, 0, 0), (0, 0, one, 0), (0, 0, 0, 1))",
+			`invalid Mat4 "((1, 0, 0, 0), (0, 1, 0, 0), (0, 0, one, 0), (0, 0, 0, 1))": row 3: component 3: invalid number "one"`),
+		Entry("angle without number",
+			parseAngle, "deg", `invalid Angle "deg": missing number`),
+		Entry("angle with unknown unit",
+			parseAngle, "90grad", `invalid Angle "90grad": invalid number "90g"`),
+	)
+
+	Specify("#MarshalText", func() {
+		Expect(NewVec2(0.1, 2.0).MarshalText()).To(Equal([]byte("(0.1, 2)")))
+		Expect(NewVec3(0.1, 2.0, -3.0).MarshalText()).To(Equal([]byte("(0.1, 2, -3)")))
+		Expect(NewVec4(1.0, 2.0, 3.0, 4.0).MarshalText()).To(Equal([]byte("(1, 2, 3, 4)")))
+		Expect(NewQuat(1.0, 0.0, 0.0, 0.0).MarshalText()).To(Equal([]byte("(1, 0, 0, 0)")))
+		Expect(NewMat2(1.0, 2.0, 3.0, 0.5).MarshalText()).To(Equal([]byte("((1, 2), (3, 0.5))")))
+		Expect(IdentityMat3().MarshalText()).To(Equal([]byte("((1, 0, 0), (0, 1, 0), (0, 0, 1))")))
+		Expect(TranslationMat4(1.0, 2.0, 3.0).MarshalText()).To(Equal([]byte("((1, 0, 0, 1), (0, 1, 0, 2), (0, 0, 1, 3), (0, 0, 0, 1))")))
+		Expect(Radians(1.5).MarshalText()).To(Equal([]byte("1.5rad")))
+	})
+
+	Specify("#UnmarshalText", func() {
+		original := RotationMat4(Degrees(30.0), 1.0, 0.0, 0.0)
+		text, err := original.MarshalText()
+		Expect(err).ToNot(HaveOccurred())
+		var matrix Mat4
+		Expect(matrix.UnmarshalText(text)).To(Succeed())
+		Expect(matrix).To(Equal(original))
+
+		var mat2 Mat2
+		Expect(mat2.UnmarshalText([]byte("((1, 2), (3, 4))"))).To(Succeed())
+		Expect(mat2).To(HaveMat2Elements(
+			1.0, 2.0,
+			3.0, 4.0,
+		))
+
+		vec := NewVec3(1.0, 2.0, 3.0)
+		Expect(vec.UnmarshalText([]byte("(1, 2)"))).ToNot(Succeed())
+		Expect(vec).To(HaveVec3Coords(1.0, 2.0, 3.0))
+
+		var angle Angle
+		Expect(angle.UnmarshalText([]byte("180deg"))).To(Succeed())
+		Expect(angle.Degrees()).To(BeNumerically("~", 180.0, 0.0001))
+	})
+
+	Specify("command-line flags", func() {
+		var (
+			position Vec3
+			rotation Angle
+		)
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags.TextVar(&position, "position", NewVec3(0.0, 0.0, 0.0), "position")
+		flags.TextVar(&rotation, "rotation", Radians(0.0), "rotation")
+		Expect(flags.Parse([]string{"-position", "(1, 2, 3)", "-rotation", "45deg"})).To(Succeed())
+		Expect(position).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(rotation.Degrees()).To(BeNumerically("~", 45.0, 0.0001))
+	})
+})
+
+func parseVec3(text string) error {
+	_, err := ParseVec3(text)
+	return err
+}
+
+func parseMat3(text string) error {
+	_, err := ParseMat3(text)
+	return err
+}
+
+func parseMat4(text string) error {
+	_, err := ParseMat4(text)
+	return err
+}
+
+func parseAngle(text string) error {
+	_, err := ParseAngle(text)
+	return err
+}
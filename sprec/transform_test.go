@@ -0,0 +1,125 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Transform", func() {
+	var transform Transform
+
+	BeforeEach(func() {
+		transform = NewTransform(
+			NewVec3(1.0, 2.0, 3.0),
+			RotationQuat(Degrees(90.0), BasisYVec3()),
+			NewVec3(2.0, 2.0, 2.0),
+		)
+	})
+
+	Specify("NewTransform", func() {
+		Expect(transform.Translation).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(transform.Rotation).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.7071067811865476, 0.0))
+		Expect(transform.Scale).To(HaveVec3Coords(2.0, 2.0, 2.0))
+	})
+
+	Specify("IdentityTransform", func() {
+		result := IdentityTransform()
+		Expect(result.Translation).To(HaveVec3Coords(0.0, 0.0, 0.0))
+		Expect(result.Rotation).To(HaveQuatCoords(1.0, 0.0, 0.0, 0.0))
+		Expect(result.Scale).To(HaveVec3Coords(1.0, 1.0, 1.0))
+		Expect(result.IsIdentity()).To(BeTrue())
+	})
+
+	Specify("Mat4ToTransform", func() {
+		result := Mat4ToTransform(TRSMat4(
+			NewVec3(1.0, 2.0, 3.0),
+			RotationQuat(Degrees(90.0), BasisYVec3()),
+			NewVec3(2.0, 3.0, 4.0),
+		))
+		Expect(result.Translation).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(result.Rotation).To(HaveQuatCoords(0.7071067811865476, 0.0, 0.7071067811865476, 0.0))
+		Expect(result.Scale).To(HaveVec3Coords(2.0, 3.0, 4.0))
+	})
+
+	Specify("TransformProd", func() {
+		child := NewTransform(
+			NewVec3(1.0, 0.0, 0.0),
+			RotationQuat(Degrees(90.0), BasisYVec3()),
+			NewVec3(0.5, 0.5, 0.5),
+		)
+		result := TransformProd(transform, child)
+		Expect(result.Translation).To(HaveVec3Coords(1.0, 2.0, 1.0))
+		Expect(result.Rotation).To(HaveQuatCoords(0.0, 0.0, 1.0, 0.0))
+		Expect(result.Scale).To(HaveVec3Coords(1.0, 1.0, 1.0))
+
+		point := NewVec3(0.0, 1.0, 0.0)
+		Expect(TransformPoint(result, point)).To(HaveVec3Coords(1.0, 3.0, 1.0))
+		Expect(TransformPoint(transform, TransformPoint(child, point))).To(HaveVec3Coords(1.0, 3.0, 1.0))
+	})
+
+	Specify("InverseTransform", func() {
+		result := InverseTransform(transform)
+		Expect(result.Translation).To(HaveVec3Coords(1.5, -1.0, -0.5))
+		Expect(result.Rotation).To(HaveQuatCoords(0.7071067811865476, 0.0, -0.7071067811865476, 0.0))
+		Expect(result.Scale).To(HaveVec3Coords(0.5, 0.5, 0.5))
+		Expect(TransformProd(transform, result).IsIdentity()).To(BeTrue())
+		Expect(TransformProd(result, transform).IsIdentity()).To(BeTrue())
+	})
+
+	Specify("TransformPoint", func() {
+		result := TransformPoint(transform, NewVec3(1.0, 0.0, 0.0))
+		Expect(result).To(HaveVec3Coords(1.0, 2.0, 1.0))
+	})
+
+	Specify("TransformDirection", func() {
+		result := TransformDirection(transform, NewVec3(0.0, 0.0, 1.0))
+		Expect(result).To(HaveVec3Coords(2.0, 0.0, 0.0))
+	})
+
+	Specify("TransformNormal", func() {
+		stretch := NewTransform(NewVec3(5.0, 5.0, 5.0), IdentityQuat(), NewVec3(2.0, 1.0, 1.0))
+		result := TransformNormal(stretch, NewVec3(1.0, 1.0, 0.0))
+		Expect(result).To(HaveVec3Coords(0.4472135954999579, 0.8944271909999159, 0.0))
+
+		result = TransformNormal(transform, NewVec3(1.0, 0.0, 0.0))
+		Expect(result).To(HaveVec3Coords(0.0, 0.0, -1.0))
+	})
+
+	Specify("TransformLerp", func() {
+		target := NewTransform(
+			NewVec3(2.0, 4.0, 6.0),
+			RotationQuat(Degrees(90.0), BasisYVec3()),
+			NewVec3(3.0, 3.0, 3.0),
+		)
+		result := TransformLerp(IdentityTransform(), target, 0.5)
+		Expect(result.Translation).To(HaveVec3Coords(1.0, 2.0, 3.0))
+		Expect(result.Rotation).To(HaveQuatCoords(0.9238795325112867, 0.0, 0.3826834323650898, 0.0))
+		Expect(result.Scale).To(HaveVec3Coords(2.0, 2.0, 2.0))
+	})
+
+	Specify("#IsNaN", func() {
+		Expect(transform.IsNaN()).To(BeFalse())
+		transform.Scale.Y = Sqrt(-1.0)
+		Expect(transform.IsNaN()).To(BeTrue())
+	})
+
+	Specify("#IsIdentity", func() {
+		Expect(transform.IsIdentity()).To(BeFalse())
+		Expect(IdentityTransform().IsIdentity()).To(BeTrue())
+	})
+
+	Specify("#Mat4", func() {
+		point := NewVec3(1.0, 2.0, 3.0)
+		result := Mat4Vec3Transformation(transform.Mat4(), point)
+		Expect(result).To(HaveVec3Coords(7.0, 6.0, 1.0))
+		Expect(TransformPoint(transform, point)).To(HaveVec3Coords(7.0, 6.0, 1.0))
+	})
+
+	Specify("#String", func() {
+		result := IdentityTransform().String()
+		Expect(result).To(Equal("((0.000000, 0.000000, 0.000000), (1.000000, 0.000000, 0.000000, 0.000000), (1.000000, 1.000000, 1.000000))"))
+	})
+})
@@ -0,0 +1,146 @@
+package sprec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Vec3Slice", func() {
+	var (
+		slice Vec3Slice
+		other Vec3Slice
+	)
+
+	BeforeEach(func() {
+		slice = NewVec3SliceFromVec3s([]Vec3{
+			NewVec3(1.0, 2.0, 2.0),
+			NewVec3(0.0, 3.0, 4.0),
+		})
+		other = NewVec3SliceFromVec3s([]Vec3{
+			NewVec3(3.0, 2.0, 1.0),
+			NewVec3(-1.0, 1.0, 0.0),
+		})
+	})
+
+	Specify("NewVec3Slice", func() {
+		result := NewVec3Slice(3)
+		Expect(result.Len()).To(Equal(3))
+		Expect(result.At(2)).To(HaveVec3Coords(0.0, 0.0, 0.0))
+	})
+
+	Specify("NewVec3SliceFromVec3s", func() {
+		Expect(slice.X).To(Equal([]float32{1.0, 0.0}))
+		Expect(slice.Y).To(Equal([]float32{2.0, 3.0}))
+		Expect(slice.Z).To(Equal([]float32{2.0, 4.0}))
+	})
+
+	Specify("#Len", func() {
+		Expect(slice.Len()).To(Equal(2))
+	})
+
+	Specify("#At", func() {
+		Expect(slice.At(1)).To(HaveVec3Coords(0.0, 3.0, 4.0))
+	})
+
+	Specify("#Set", func() {
+		slice.Set(0, NewVec3(7.0, 8.0, 9.0))
+		Expect(slice.At(0)).To(HaveVec3Coords(7.0, 8.0, 9.0))
+		Expect(slice.At(1)).To(HaveVec3Coords(0.0, 3.0, 4.0))
+	})
+
+	Specify("#AppendVec3s", func() {
+		result := slice.AppendVec3s([]Vec3{NewVec3(9.0, 9.0, 9.0)})
+		Expect(result).To(HaveLen(3))
+		Expect(result[0]).To(HaveVec3Coords(9.0, 9.0, 9.0))
+		Expect(result[1]).To(HaveVec3Coords(1.0, 2.0, 2.0))
+		Expect(result[2]).To(HaveVec3Coords(0.0, 3.0, 4.0))
+	})
+
+	Specify("#Add", func() {
+		slice.Add(other)
+		Expect(slice.At(0)).To(HaveVec3Coords(4.0, 4.0, 3.0))
+		Expect(slice.At(1)).To(HaveVec3Coords(-1.0, 4.0, 4.0))
+	})
+
+	Specify("#AddScaled", func() {
+		slice.AddScaled(other, 0.5)
+		Expect(slice.At(0)).To(HaveVec3Coords(2.5, 3.0, 2.5))
+		Expect(slice.At(1)).To(HaveVec3Coords(-0.5, 3.5, 4.0))
+	})
+
+	Specify("#Scale", func() {
+		slice.Scale(2.0)
+		Expect(slice.At(0)).To(HaveVec3Coords(2.0, 4.0, 4.0))
+		Expect(slice.At(1)).To(HaveVec3Coords(0.0, 6.0, 8.0))
+	})
+
+	Specify("#Dot", func() {
+		result := make([]float32, 2)
+		slice.Dot(other, result)
+		Expect(result[0]).To(EqualFloat32(9.0))
+		Expect(result[1]).To(EqualFloat32(3.0))
+	})
+
+	Specify("#Length", func() {
+		result := make([]float32, 2)
+		slice.Length(result)
+		Expect(result[0]).To(EqualFloat32(3.0))
+		Expect(result[1]).To(EqualFloat32(5.0))
+	})
+
+	Specify("#Normalize", func() {
+		slice.Normalize()
+		Expect(slice.At(0)).To(HaveVec3Coords(1.0/3.0, 2.0/3.0, 2.0/3.0))
+		Expect(slice.At(1)).To(HaveVec3Coords(0.0, 0.6, 0.8))
+	})
+
+	Specify("#Lerp", func() {
+		slice.Lerp(other, 0.25)
+		expected := Vec3Lerp(NewVec3(1.0, 2.0, 2.0), NewVec3(3.0, 2.0, 1.0), 0.25)
+		Expect(slice.At(0)).To(HaveVec3Coords(expected.X, expected.Y, expected.Z))
+		Expect(slice.At(1)).To(HaveVec3Coords(-0.25, 2.5, 3.0))
+	})
+
+	Specify("#Transform", func() {
+		mat := Mat4Prod(
+			TranslationMat4(1.0, 2.0, 3.0),
+			RotationMat4(Degrees(90.0), 0.0, 0.0, 1.0),
+		)
+		slice.Transform(mat)
+		Expect(slice.At(0)).To(HaveVec3Coords(-1.0, 3.0, 5.0))
+		Expect(slice.At(1)).To(HaveVec3Coords(-2.0, 2.0, 7.0))
+	})
+
+	It("panics if the other slice is shorter", func() {
+		Expect(func() {
+			slice.Add(NewVec3Slice(1))
+		}).To(Panic())
+	})
+
+	It("panics if the other slice is shorter despite its capacity", func() {
+		short := Vec3Slice{
+			X: make([]float32, 1, 8),
+			Y: make([]float32, 1, 8),
+			Z: make([]float32, 1, 8),
+		}
+		message := "Vec3Slice has 1 vectors but 2 are required"
+		Expect(func() { slice.Add(short) }).To(PanicWith(message))
+		Expect(func() { slice.AddScaled(short, 2.0) }).To(PanicWith(message))
+		Expect(func() { slice.Dot(short, make([]float32, 2)) }).To(PanicWith(message))
+		Expect(func() { slice.Lerp(short, 0.5) }).To(PanicWith(message))
+	})
+
+	It("panics if the destination is shorter despite its capacity", func() {
+		message := "Vec3Slice destination has 1 elements but 2 are required"
+		Expect(func() { slice.Dot(other, make([]float32, 1, 8)) }).To(PanicWith(message))
+		Expect(func() { slice.Length(make([]float32, 1, 8)) }).To(PanicWith(message))
+	})
+
+	It("panics if the components have different lengths", func() {
+		slice.Y = slice.Y[:1]
+		Expect(func() { slice.Scale(2.0) }).To(PanicWith("Vec3Slice components have different lengths (2, 1, 2)"))
+	})
+})
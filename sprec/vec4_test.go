@@ -91,6 +91,11 @@ var _ = Describe("Vec4", func() {
 		Expect(result).To(HaveVec4Coords(2.0, 2.5, 3.0, 3.5))
 	})
 
+	Specify("UnitVec4", func() {
+		result := UnitVec4(firstVector)
+		Expect(result).To(HaveVec4Coords(0.2721655269759087, 0.408248290463863, 0.5443310539518174, 0.6804138174397717))
+	})
+
 	Specify("InverseVec4", func() {
 		result := InverseVec4(firstVector)
 		Expect(result).To(HaveVec4Coords(-2.0, -3.0, -4.0, -5.0))
@@ -148,6 +153,14 @@ var _ = Describe("Vec4", func() {
 		Expect(NewVec4(0.0, 0.0, 0.0, Epsilon).IsZero()).To(BeFalse())
 	})
 
+	Specify("#SqrLength", func() {
+		Expect(firstVector.SqrLength()).To(EqualFloat32(54.0))
+	})
+
+	Specify("#Length", func() {
+		Expect(firstVector.Length()).To(EqualFloat32(7.3484692283495345))
+	})
+
 	Specify("#VecXYZ", func() {
 		Expect(firstVector.VecXYZ()).To(HaveVec3Coords(2.0, 3.0, 4.0))
 	})
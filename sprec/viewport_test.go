@@ -0,0 +1,102 @@
+package sprec_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/mokiat/gomath/sprec"
+	. "github.com/mokiat/gomath/testing/sprectest"
+)
+
+var _ = Describe("Viewport", func() {
+	var (
+		viewport       Viewport
+		viewProjection Mat4
+		mapping        DepthMapping
+	)
+
+	BeforeEach(func() {
+		viewport = NewViewport(10.0, 20.0, 200.0, 100.0)
+		mapping = DepthMapping{}
+		viewProjection = Mat4Prod(
+			PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, 3.0, mapping),
+			LookAtMat4(NewVec3(0.0, 0.0, 5.0), ZeroVec3(), BasisYVec3()),
+		)
+	})
+
+	Specify("NewViewport", func() {
+		Expect(viewport.X).To(EqualFloat32(10.0))
+		Expect(viewport.Y).To(EqualFloat32(20.0))
+		Expect(viewport.Width).To(EqualFloat32(200.0))
+		Expect(viewport.Height).To(EqualFloat32(100.0))
+	})
+
+	Specify("#AspectRatio", func() {
+		Expect(viewport.AspectRatio()).To(EqualFloat32(2.0))
+	})
+
+	Describe("ProjectVec3", func() {
+		Specify("center point", func() {
+			window, ok := ProjectVec3(viewProjection, viewport, mapping, NewVec3(0.0, 0.0, 3.0))
+			Expect(ok).To(BeTrue())
+			Expect(window).To(HaveVec3Coords(110.0, 70.0, 0.75))
+		})
+
+		Specify("corner point", func() {
+			window, ok := ProjectVec3(viewProjection, viewport, mapping, NewVec3(2.0, 2.0, 3.0))
+			Expect(ok).To(BeTrue())
+			Expect(window).To(HaveVec3Coords(210.0, 20.0, 0.75))
+		})
+
+		Specify("zero to one depth range", func() {
+			mapping = DepthMapping{Range: DepthRangeZeroToOne}
+			viewProjection = PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, 3.0, mapping)
+			window, ok := ProjectVec3(viewProjection, viewport, mapping, NewVec3(-1.0, -1.0, -1.0))
+			Expect(ok).To(BeTrue())
+			Expect(window).To(HaveVec3Coords(10.0, 120.0, 0.0))
+		})
+
+		Specify("point behind the camera", func() {
+			_, ok := ProjectVec3(viewProjection, viewport, mapping, NewVec3(0.0, 0.0, 6.0))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Specify("UnprojectVec3", func() {
+		point := UnprojectVec3(viewProjection, viewport, mapping, NewVec3(210.0, 20.0, 0.75))
+		Expect(point).To(HaveVec3Coords(2.0, 2.0, 3.0))
+
+		point = UnprojectVec3(viewProjection, viewport, mapping, NewVec3(110.0, 70.0, 0.0))
+		Expect(point).To(HaveVec3Coords(0.0, 0.0, 4.0))
+	})
+
+	Specify("InverseUnprojectVec3", func() {
+		inverseViewProjection := InverseMat4(viewProjection)
+		point := InverseUnprojectVec3(inverseViewProjection, viewport, mapping, NewVec3(210.0, 20.0, 0.75))
+		Expect(point).To(HaveVec3Coords(2.0, 2.0, 3.0))
+	})
+
+	Describe("PixelRay", func() {
+		Specify("center pixel", func() {
+			ray := PixelRay(viewProjection, viewport, mapping, 110.0, 70.0)
+			Expect(ray.Origin).To(HaveVec3Coords(0.0, 0.0, 4.0))
+			Expect(ray.Direction).To(HaveVec3Coords(0.0, 0.0, -1.0))
+		})
+
+		Specify("corner pixel", func() {
+			ray := PixelRay(viewProjection, viewport, mapping, 210.0, 20.0)
+			Expect(ray.Origin).To(HaveVec3Coords(1.0, 1.0, 4.0))
+			Expect(ray.Direction).To(HaveVec3Coords(0.5773502691896258, 0.5773502691896258, -0.5773502691896258))
+		})
+
+		Specify("reversed infinite projection", func() {
+			mapping = DepthMapping{Range: DepthRangeZeroToOne, Reversed: true}
+			viewProjection = PerspectiveDepthMat4(-1.0, 1.0, -1.0, 1.0, 1.0, float32(math.Inf(1)), mapping)
+			ray := PixelRay(viewProjection, viewport, mapping, 210.0, 20.0)
+			Expect(ray.Origin).To(HaveVec3Coords(1.0, 1.0, -1.0))
+			Expect(ray.Direction).To(HaveVec3Coords(0.5773502691896258, 0.5773502691896258, -0.5773502691896258))
+		})
+	})
+})
@@ -52,6 +52,18 @@ func Quat(src sprec.Quat) dprec.Quat {
 	}
 }
 
+// Mat2 converts the specified single-precision Mat2 into a double-precision
+// Mat2.
+func Mat2(src sprec.Mat2) dprec.Mat2 {
+	return dprec.Mat2{
+		M11: float64(src.M11),
+		M12: float64(src.M12),
+
+		M21: float64(src.M21),
+		M22: float64(src.M22),
+	}
+}
+
 // Mat3 converts the specified single-precision Mat3 into a double-precision
 // Mat3.
 func Mat3(src sprec.Mat3) dprec.Mat3 {
@@ -95,3 +107,49 @@ func Mat4(src sprec.Mat4) dprec.Mat4 {
 		M44: float64(src.M44),
 	}
 }
+
+// AABB converts the specified single-precision AABB into a double-precision
+// AABB.
+func AABB(src sprec.AABB) dprec.AABB {
+	return dprec.AABB{
+		Min: Vec3(src.Min),
+		Max: Vec3(src.Max),
+	}
+}
+
+// Ray converts the specified single-precision Ray into a double-precision
+// Ray.
+func Ray(src sprec.Ray) dprec.Ray {
+	return dprec.Ray{
+		Origin:    Vec3(src.Origin),
+		Direction: Vec3(src.Direction),
+	}
+}
+
+// Plane converts the specified single-precision Plane into a
+// double-precision Plane.
+func Plane(src sprec.Plane) dprec.Plane {
+	return dprec.Plane{
+		Normal:   Vec3(src.Normal),
+		Distance: float64(src.Distance),
+	}
+}
+
+// DualQuat converts the specified single-precision DualQuat into a
+// double-precision DualQuat.
+func DualQuat(src sprec.DualQuat) dprec.DualQuat {
+	return dprec.DualQuat{
+		Real: Quat(src.Real),
+		Dual: Quat(src.Dual),
+	}
+}
+
+// Transform converts the specified single-precision Transform into a
+// double-precision Transform.
+func Transform(src sprec.Transform) dprec.Transform {
+	return dprec.Transform{
+		Translation: Vec3(src.Translation),
+		Rotation:    Quat(src.Rotation),
+		Scale:       Vec3(src.Scale),
+	}
+}
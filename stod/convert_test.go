@@ -0,0 +1,50 @@
+package stod_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/mokiat/gomath/dprec"
+	"github.com/mokiat/gomath/dtos"
+	"github.com/mokiat/gomath/sprec"
+	"github.com/mokiat/gomath/stod"
+)
+
+var _ = Describe("Convert", func() {
+	Specify("Mat2", func() {
+		matrix := sprec.NewMat2(
+			1.5, -2.0,
+			0.25, 4.0,
+		)
+		converted := stod.Mat2(matrix)
+		Expect(converted).To(Equal(dprec.NewMat2(
+			1.5, -2.0,
+			0.25, 4.0,
+		)))
+		Expect(dtos.Mat2(converted)).To(Equal(matrix))
+	})
+
+	Specify("DualQuat", func() {
+		dual := sprec.RotationTranslationDualQuat(
+			sprec.RotationQuat(sprec.Degrees(90.0), sprec.BasisYVec3()),
+			sprec.NewVec3(1.0, 2.0, 3.0),
+		)
+		converted := stod.DualQuat(dual)
+		Expect(converted.Real).To(Equal(stod.Quat(dual.Real)))
+		Expect(converted.Dual).To(Equal(stod.Quat(dual.Dual)))
+		Expect(dtos.DualQuat(converted)).To(Equal(dual))
+	})
+
+	Specify("Transform", func() {
+		transform := sprec.NewTransform(
+			sprec.NewVec3(1.0, 2.0, 3.0),
+			sprec.RotationQuat(sprec.Degrees(30.0), sprec.BasisXVec3()),
+			sprec.NewVec3(0.5, 2.0, 4.0),
+		)
+		converted := stod.Transform(transform)
+		Expect(converted.Translation).To(Equal(dprec.NewVec3(1.0, 2.0, 3.0)))
+		Expect(converted.Rotation).To(Equal(stod.Quat(transform.Rotation)))
+		Expect(converted.Scale).To(Equal(dprec.NewVec3(0.5, 2.0, 4.0)))
+		Expect(dtos.Transform(converted)).To(Equal(transform))
+	})
+})
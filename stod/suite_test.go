@@ -0,0 +1,13 @@
+package stod_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestStod(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Precision Conversion Suite")
+}
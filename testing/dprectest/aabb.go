@@ -0,0 +1,28 @@
+package dprectest
+
+import (
+	"fmt"
+
+	"github.com/mokiat/gomath/dprec"
+	"github.com/mokiat/gomath/testing"
+	"github.com/onsi/gomega/types"
+)
+
+func HaveAABBBounds(expectedMinX, expectedMinY, expectedMinZ, expectedMaxX, expectedMaxY, expectedMaxZ float64) types.GomegaMatcher {
+	return testing.GenericMatcher(
+		func(box dprec.AABB) bool {
+			return AreEqualFloat64(box.Min.X, expectedMinX) &&
+				AreEqualFloat64(box.Min.Y, expectedMinY) &&
+				AreEqualFloat64(box.Min.Z, expectedMinZ) &&
+				AreEqualFloat64(box.Max.X, expectedMaxX) &&
+				AreEqualFloat64(box.Max.Y, expectedMaxY) &&
+				AreEqualFloat64(box.Max.Z, expectedMaxZ)
+		},
+		func(box dprec.AABB) string {
+			return fmt.Sprintf("Expected\n\t%#v\nto have bounds\n\t(%f, %f, %f) - (%f, %f, %f)", box, expectedMinX, expectedMinY, expectedMinZ, expectedMaxX, expectedMaxY, expectedMaxZ)
+		},
+		func(box dprec.AABB) string {
+			return fmt.Sprintf("Expected\n\t%#v\nnot to have bounds\n\t(%f, %f, %f) - (%f, %f, %f)", box, expectedMinX, expectedMinY, expectedMinZ, expectedMaxX, expectedMaxY, expectedMaxZ)
+		},
+	)
+}
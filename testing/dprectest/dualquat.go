@@ -0,0 +1,39 @@
+package dprectest
+
+import (
+	"fmt"
+
+	"github.com/mokiat/gomath/dprec"
+	"github.com/mokiat/gomath/testing"
+	"github.com/onsi/gomega/types"
+)
+
+func HaveDualQuatCoords(
+	expectedRealW, expectedRealX, expectedRealY, expectedRealZ float64,
+	expectedDualW, expectedDualX, expectedDualY, expectedDualZ float64,
+) types.GomegaMatcher {
+	return testing.GenericMatcher(
+		func(quat dprec.DualQuat) bool {
+			return AreEqualFloat64(quat.Real.W, expectedRealW) &&
+				AreEqualFloat64(quat.Real.X, expectedRealX) &&
+				AreEqualFloat64(quat.Real.Y, expectedRealY) &&
+				AreEqualFloat64(quat.Real.Z, expectedRealZ) &&
+				AreEqualFloat64(quat.Dual.W, expectedDualW) &&
+				AreEqualFloat64(quat.Dual.X, expectedDualX) &&
+				AreEqualFloat64(quat.Dual.Y, expectedDualY) &&
+				AreEqualFloat64(quat.Dual.Z, expectedDualZ)
+		},
+		func(quat dprec.DualQuat) string {
+			return fmt.Sprintf("Expected\n\t%#v\nto have coords\n\t((%f, %f, %f, %f), (%f, %f, %f, %f))", quat,
+				expectedRealW, expectedRealX, expectedRealY, expectedRealZ,
+				expectedDualW, expectedDualX, expectedDualY, expectedDualZ,
+			)
+		},
+		func(quat dprec.DualQuat) string {
+			return fmt.Sprintf("Expected\n\t%#v\nnot to have coords\n\t((%f, %f, %f, %f), (%f, %f, %f, %f))", quat,
+				expectedRealW, expectedRealX, expectedRealY, expectedRealZ,
+				expectedDualW, expectedDualX, expectedDualY, expectedDualZ,
+			)
+		},
+	)
+}
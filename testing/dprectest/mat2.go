@@ -0,0 +1,49 @@
+package dprectest
+
+import (
+	"fmt"
+
+	"github.com/mokiat/gomath/dprec"
+	"github.com/mokiat/gomath/testing"
+	"github.com/onsi/gomega/types"
+)
+
+func HaveMat2Elements(
+	e11, e12 float64,
+	e21, e22 float64,
+) types.GomegaMatcher {
+	return testing.GenericMatcher(
+		func(matrix dprec.Mat2) bool {
+			return AreEqualFloat64(matrix.M11, e11) &&
+				AreEqualFloat64(matrix.M12, e12) &&
+				AreEqualFloat64(matrix.M21, e21) &&
+				AreEqualFloat64(matrix.M22, e22)
+		},
+		func(matrix dprec.Mat2) string {
+			return fmt.Sprintf(`Expected
+	(%f, %f)
+	(%f, %f)
+to have elements
+	(%f, %f)
+	(%f, %f)`,
+				matrix.M11, matrix.M12,
+				matrix.M21, matrix.M22,
+				e11, e12,
+				e21, e22,
+			)
+		},
+		func(matrix dprec.Mat2) string {
+			return fmt.Sprintf(`Expected
+	(%f, %f)
+	(%f, %f)
+not to have elements
+	(%f, %f)
+	(%f, %f)`,
+				matrix.M11, matrix.M12,
+				matrix.M21, matrix.M22,
+				e11, e12,
+				e21, e22,
+			)
+		},
+	)
+}
@@ -0,0 +1,26 @@
+package dprectest
+
+import (
+	"fmt"
+
+	"github.com/mokiat/gomath/dprec"
+	"github.com/mokiat/gomath/testing"
+	"github.com/onsi/gomega/types"
+)
+
+func HavePlaneElements(expectedNormalX, expectedNormalY, expectedNormalZ, expectedDistance float64) types.GomegaMatcher {
+	return testing.GenericMatcher(
+		func(plane dprec.Plane) bool {
+			return AreEqualFloat64(plane.Normal.X, expectedNormalX) &&
+				AreEqualFloat64(plane.Normal.Y, expectedNormalY) &&
+				AreEqualFloat64(plane.Normal.Z, expectedNormalZ) &&
+				AreEqualFloat64(plane.Distance, expectedDistance)
+		},
+		func(plane dprec.Plane) string {
+			return fmt.Sprintf("Expected\n\t%#v\nto have elements\n\t(%f, %f, %f, %f)", plane, expectedNormalX, expectedNormalY, expectedNormalZ, expectedDistance)
+		},
+		func(plane dprec.Plane) string {
+			return fmt.Sprintf("Expected\n\t%#v\nnot to have elements\n\t(%f, %f, %f, %f)", plane, expectedNormalX, expectedNormalY, expectedNormalZ, expectedDistance)
+		},
+	)
+}
@@ -0,0 +1,6 @@
+package sprectest
+
+// The common.go file is maintained by hand, since its float32 comparison
+// is performed in float64 and cannot be derived from the float64 one.
+
+//go:generate go run ../../cmd/precgen -src ../dprectest -dst . -skip common.go